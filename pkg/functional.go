@@ -1,9 +1,18 @@
 package functional
 
 import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
 	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/standoffvenus/functional/v2/pkg/iterator"
+	"github.com/standoffvenus/functional/v2/pkg/optional"
 )
 
 // Break is a function that should be called when the caller
@@ -20,16 +29,99 @@ type Comparable interface {
 	Less(Comparable) bool
 }
 
+// Composer builds a function from A to B one step at a time,
+// allowing each step to change the type of the result. Since
+// methods may not introduce type parameters, steps are added
+// with Then rather than a method, i.e.
+//  Then(Then(Start[string](), strconv.Quote), utf8.RuneCountInString).Build()
+// is a func(string) int. Composers should be created with
+// Start; the zero value has no function to compose with.
+type Composer[A, B any] struct{ fn func(A) B }
+
 type (
 	// comparables is used implement sort.Interface on a collection
 	// of generic T.
 	comparables[T Comparable] []T
 
+	// filtered is the iterator returned from Filter.
+	filtered[T any] struct {
+		source iterator.Iterator[T]
+		fn     func(T) bool
+	}
+
+	// mapped is the iterator returned from Map.
+	mapped[From, To any] struct {
+		source iterator.Iterator[From]
+		fn     func(From) To
+	}
+
+	// sizedMapped is the iterator returned from Map when
+	// the source iterator implements Enumerable.
+	sizedMapped[From, To any] struct{ mapped[From, To] }
+
+	// mergeHeap implements heap.Interface on the current
+	// values of the iterators passed to MergeSorted.
+	mergeHeap[T any] struct {
+		heads []mergeHead[T]
+		less  func(a, b T) bool
+	}
+
+	// mergeHead is the current value of an iterator passed
+	// to MergeSorted. The iterator's argument position is
+	// used to break ties, keeping the merge stable.
+	mergeHead[T any] struct {
+		value    T
+		source   iterator.Iterator[T]
+		position int
+	}
+
+	// partition holds the state shared between the iterators
+	// returned from PartitionIter. Values pulled from the source
+	// on behalf of one branch are buffered for the other.
+	partition[T any] struct {
+		source    iterator.Iterator[T]
+		pred      func(T) bool
+		matched   []T
+		unmatched []T
+	}
+
+	// sizeHinter is implemented by iterators that can estimate,
+	// but not guarantee, their remaining number of values. Unlike
+	// Enumerable, it is only used by getSizeHint.
+	sizeHinter interface{ sizeHint() int }
+
 	// sorted is used to represent a sorted iterator. It is returned
 	// from Sort to quickly check if an iterator is sorted.
 	sorted[T any] struct{ iterator.Iterator[T] }
 )
 
+var _ sizeHinter = filtered[int]{}
+var _ iterator.Enumerable[int] = sizedMapped[int, int]{}
+
+// Aggregate will invoke accumulate on each element of the
+// given iterator, starting with seed as the accumulated value,
+// before returning the result of calling finalize on the final
+// accumulated value. Aggregate allows the accumulated type to
+// differ from the result type, e.g. accumulating a sum and a
+// count before finalizing to an average.
+func Aggregate[From, Acc, Result any](
+	iter iterator.Iterator[From],
+	seed Acc,
+	accumulate func(Acc, From) Acc,
+	finalize func(Acc) Result,
+) Result {
+	accumulator := seed
+	ForEach(iter, func(x From, _ Break) {
+		accumulator = accumulate(accumulator, x)
+	})
+
+	return finalize(accumulator)
+}
+
+// pools holds a *sync.Pool of *[]T for each type T passed
+// to CollectPooled, keyed by the reflect.Type of *T.
+var pools sync.Map
+
 // All will return whether the provided function holds true over
 // all values in the iterator. If the iterator is empty, All will
 // return true. All short-curcuits on the first value "x" such
@@ -53,9 +145,184 @@ func Any[T any](iter iterator.Iterator[T], fn func(T) bool) bool {
 	return any
 }
 
+// AtLeast will return whether at least n values "x" of the
+// provided iterator are such that pred(x) holds true. AtLeast
+// short-circuits once the n-th such value is found; if n is
+// not positive, no values are retrieved.
+func AtLeast[T any](iter iterator.Iterator[T], pred func(T) bool, n int) bool {
+	return countUpTo(iter, pred, n) >= n
+}
+
+// AtMost will return whether at most n values "x" of the
+// provided iterator are such that pred(x) holds true. AtMost
+// short-circuits once the (n+1)-th such value is found.
+func AtMost[T any](iter iterator.Iterator[T], pred func(T) bool, n int) bool {
+	return countUpTo(iter, pred, n+1) <= n
+}
+
+// BuildString will call fn with a shared strings.Builder for
+// each value of the provided iterator, returning the built
+// string. Unlike mapping values to strings and joining them,
+// no intermediate strings need to be allocated. The iterator's
+// size hint is used to estimate the capacity of the result.
+func BuildString[T any](iter iterator.Iterator[T], fn func(*strings.Builder, T)) string {
+	var builder strings.Builder
+	builder.Grow(getSizeHint(iter))
+
+	ForEach(iter, func(t T, _ Break) {
+		fn(&builder, t)
+	})
+
+	return builder.String()
+}
+
+// Chain will compose the provided functions from right to
+// left, i.e.
+//  Chain(f, g, h)(x) // == f(g(h(x)))
+// If no functions are provided, the returned function
+// returns its argument unchanged. If any function is nil,
+// Chain will panic.
+func Chain[T any](fns ...func(T) T) func(T) T {
+	return ChainIter[T](&iterator.Slice[func(T) T]{Values: fns})
+}
+
+// ChainIter is the same as Chain, except the functions to
+// compose are drawn from the provided iterator. The first
+// value of the iterator is the outermost function. The
+// iterator is drained when ChainIter is called.
+func ChainIter[T any](fns iterator.Iterator[func(T) T]) func(T) T {
+	chain := Collect(fns)
+	for idx, fn := range chain {
+		if fn == nil {
+			bork("nil function at index %d of chain", idx)
+		}
+	}
+
+	return func(t T) T {
+		for idx := len(chain) - 1; idx >= 0; idx-- {
+			t = chain[idx](t)
+		}
+
+		return t
+	}
+}
+
+// ChainUntil will compose the provided functions from left
+// to right, i.e. unlike Chain, the first function is applied
+// first. Before each function is applied, pred is checked
+// against the current value; once pred holds true, the
+// remaining functions are skipped and the value is returned.
+// If any function is nil, ChainUntil will panic.
+func ChainUntil[T any](pred func(T) bool, fns ...func(T) T) func(T) T {
+	for idx, fn := range fns {
+		if fn == nil {
+			bork("nil function at index %d of chain", idx)
+		}
+	}
+
+	return func(t T) T {
+		for _, fn := range fns {
+			if pred(t) {
+				break
+			}
+
+			t = fn(t)
+		}
+
+		return t
+	}
+}
+
+// ChunkExact will return an iterator on batches of exactly
+// the provided size. Unlike ChunkToChan, a final batch with
+// fewer than size values is not returned; those values are
+// retrieved from the provided iterator and dropped.
+//
+// If size is not positive, ChunkExact will panic.
+func ChunkExact[T any](iter iterator.Iterator[T], size int) iterator.Iterator[[]T] {
+	if size <= 0 {
+		bork("non-positive chunk size %d passed to chunk exact", size)
+	}
+
+	return iterator.Func[[]T](func() optional.Option[[]T] {
+		if chunk := take(iter, make([]T, 0, size), size); len(chunk) == size {
+			return optional.Some(chunk)
+		}
+
+		return optional.None[[]T]()
+	})
+}
+
+// ChunkReuse will return an iterator on batches of the
+// provided size, where the final batch may be shorter. To
+// avoid allocating each batch, every batch shares the same
+// backing array.
+//
+// WARNING: a batch is overwritten by the next call to Next().
+// Callers that retain a batch past the next call must copy it.
+//
+// If size is not positive, ChunkReuse will panic.
+func ChunkReuse[T any](iter iterator.Iterator[T], size int) iterator.Iterator[[]T] {
+	if size <= 0 {
+		bork("non-positive chunk size %d passed to chunk reuse", size)
+	}
+
+	buffer := make([]T, 0, size)
+
+	return iterator.Func[[]T](func() optional.Option[[]T] {
+		if buffer = take(iter, buffer[:0], size); len(buffer) > 0 {
+			return optional.Some(buffer)
+		}
+
+		return optional.None[[]T]()
+	})
+}
+
+// ChunkToChan will call Next(), sending the values in
+// batches of the provided size to the returned channel
+// on a separate Goroutine. Once None is encountered, any
+// remaining values are sent as a final, shorter batch and
+// the channel is closed. If size is not positive,
+// ChunkToChan will panic.
+func ChunkToChan[T any](iter iterator.Iterator[T], size int) <-chan []T {
+	if size <= 0 {
+		bork("non-positive chunk size %d passed to chunk to chan", size)
+	}
+
+	ch := make(chan []T)
+	go func(c chan []T) {
+		defer close(c)
+
+		chunk := make([]T, 0, size)
+		ForEach(iter, func(t T, _ Break) {
+			if chunk = append(chunk, t); len(chunk) == size {
+				c <- chunk
+				chunk = make([]T, 0, size)
+			}
+		})
+
+		if len(chunk) > 0 {
+			c <- chunk
+		}
+	}(ch)
+
+	return ch
+}
+
 // Collect will call Next(), storing the results in a slice
 // until None is encountered.
+//
+// If iter is a *iterator.Slice, its remaining values are
+// copied at once rather than retrieved one at a time.
 func Collect[T any](iter iterator.Iterator[T]) []T {
+	if s, ok := iter.(*iterator.Slice[T]); ok && s != nil {
+		end := len(s.Values)
+		slice := append(make([]T, 0, s.Count()), s.Values[end-s.Count():]...)
+		*s = *iterator.SliceRange(s.Values, end, end)
+
+		return slice
+	}
+
 	slice := allocate[T](iter)
 	ForEach(iter, func(t T, b Break) {
 		slice = append(slice, t)
@@ -64,6 +331,72 @@ func Collect[T any](iter iterator.Iterator[T]) []T {
 	return slice
 }
 
+// CollectContext is the same as Collect, except values are
+// retrieved as in ReduceContext: blocking iterators are waited
+// on with their WaitForNext method, and the context is checked
+// between values. If ctx is canceled before the iterator is exhausted, the
+// values collected so far are returned alongside the
+// context's error.
+func CollectContext[T any](ctx context.Context, iter iterator.Iterator[T]) ([]T, error) {
+	return ReduceContext(ctx, iter, allocate[T](iter), func(slice []T, t T) []T {
+		return append(slice, t)
+	})
+}
+
+// CollectPooled is the same as Collect, except the returned
+// slice's backing array is taken from a sync.Pool. Calling
+// the returned release function zeroes the slice and returns
+// its backing array to the pool; the slice must not be used
+// after release is called. Calling release more than once
+// has no effect.
+func CollectPooled[T any](iter iterator.Iterator[T]) ([]T, func()) {
+	pool := poolOf[T]()
+	buffer := pool.Get().(*[]T)
+
+	values := (*buffer)[:0]
+	ForEach(iter, func(t T, _ Break) {
+		values = append(values, t)
+	})
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			var zero T
+			for idx := range values {
+				values[idx] = zero
+			}
+
+			*buffer = values[:0]
+			pool.Put(buffer)
+		})
+	}
+
+	return values, release
+}
+
+// CollectResults will call Next(), storing the values of
+// OK results in a slice until None is encountered. If an
+// erroneous result is encountered, CollectResults stops
+// iterating and returns that result's error.
+func CollectResults[T any](iter iterator.Iterator[optional.Result[T]]) optional.Result[[]T] {
+	var failed optional.Option[error]
+	values := allocate[T](iter)
+	ForEach(iter, func(r optional.Result[T], stop Break) {
+		if !r.Ok() {
+			failed = optional.Some(r.Err())
+			stop()
+		} else {
+			values = append(values, r.Expect())
+		}
+	})
+
+	if failed.IsSome() {
+		return optional.Err[[]T](failed.Get())
+	}
+
+	return optional.Ok(values)
+}
+
 // CollectToChan will call Next(), sending the results to the
 // returned channel on a separate Goroutine until None is
 // encountered.
@@ -79,41 +412,295 @@ func CollectToChan[T any](iter iterator.Iterator[T]) <-chan T {
 	return ch
 }
 
-// Equal will check if two iterators equal by collecting their
-// values and comparing the resulting slices. If the iterator's
-// are different sizes, false is returned.
-func Equal[T comparable](a, b iterator.Iterator[T]) bool {
-	// Preliminary check on length to avoid collecting
-	// both iterators if possible
-	if getSizeHint(a) != getSizeHint(b) {
-		return false
+// CollectToChanContext is the same as CollectToChan, except
+// the returned channel has the provided buffer size and the
+// Goroutine sending values stops once the provided context
+// is canceled, closing the channel. Cancel the context when
+// the channel is no longer read from to avoid leaking the
+// Goroutine. If iter implements iterator.BlockingIterator, the
+// Goroutine also stops waiting on iter once the context is
+// canceled.
+func CollectToChanContext[T any](ctx context.Context, iter iterator.Iterator[T], buffer int) <-chan T {
+	if buffer < 0 {
+		bork("negative buffer %d passed to collect to chan context", buffer)
 	}
 
-	aValues, bValues := Collect(a), Collect(b)
-	if len(aValues) != len(bValues) {
+	ch := make(chan T, buffer)
+	go func(c chan T) {
+		defer close(c)
+		if iter == nil {
+			return
+		}
+
+		blocking, isBlocking := iter.(iterator.BlockingIterator[T])
+		for ctx.Err() == nil {
+			var opt optional.Option[T]
+			if isBlocking {
+				opt = blocking.WaitForNext(ctx)
+			} else {
+				opt = iter.Next()
+			}
+
+			if opt.IsNone() {
+				return
+			}
+
+			select {
+			case c <- opt.Expect():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}(ch)
+
+	return ch
+}
+
+// CompactOptions will return an iterator on the values of
+// the provided iterator's Some options, skipping any None.
+// CompactOptions is equivalent to calling FilterMap with a
+// function that returns its argument.
+func CompactOptions[T any](iter iterator.Iterator[optional.Option[T]]) iterator.Iterator[T] {
+	return FilterMap(iter, func(opt optional.Option[T]) optional.Option[T] { return opt })
+}
+
+// Count will call Next() until None is encountered,
+// returning the number of values retrieved. Count may
+// be used to force a lazy iterator to be evaluated to
+// completion, discarding its values. If iter is nil,
+// Count returns 0.
+func Count[T any](iter iterator.Iterator[T]) int {
+	return CountBy(iter, func(T) bool { return true })
+}
+
+// Count64 is the same as Count, except the count is an int64.
+// Count64 should be preferred over Count for iterators that
+// may have more values than an int can represent, such as
+// long streams on platforms where int is 32 bits.
+func Count64[T any](iter iterator.Iterator[T]) int64 {
+	var count int64
+	ForEach(iter, func(T, Break) {
+		count++
+	})
+
+	return count
+}
+
+// CountBy will call Next() until None is encountered,
+// returning the number of values "x" such that pred(x)
+// holds true.
+func CountBy[T any](iter iterator.Iterator[T], pred func(T) bool) int {
+	count := 0
+	ForEach(iter, func(t T, _ Break) {
+		if pred(t) {
+			count++
+		}
+	})
+
+	return count
+}
+
+// CountDistinct will call Next() until None is encountered,
+// returning the number of distinct values retrieved. Every
+// distinct value is held in memory until CountDistinct
+// returns.
+func CountDistinct[T comparable](iter iterator.Iterator[T]) int {
+	seen := make(map[T]struct{}, getSizeHint(iter))
+	ForEach(iter, func(t T, _ Break) {
+		seen[t] = struct{}{}
+	})
+
+	return len(seen)
+}
+
+// Cycle will return an iterator that yields the values of
+// the provided iterator, then repeats them forever. Values
+// are buffered as they are retrieved on the first pass.
+// If the provided iterator is empty, the returned iterator
+// is exhausted.
+func Cycle[T any](iter iterator.Enumerable[T]) iterator.Iterator[T] {
+	buffer := make([]T, 0, iter.Count())
+	idx, replaying := 0, false
+
+	return iterator.Func[T](func() optional.Option[T] {
+		if !replaying {
+			if opt := iter.Next(); opt.IsSome() {
+				buffer = append(buffer, opt.Expect())
+				return opt
+			}
+
+			replaying = true
+		}
+
+		if len(buffer) == 0 {
+			return optional.None[T]()
+		}
+
+		v := buffer[idx]
+		idx = (idx + 1) % len(buffer)
+
+		return optional.Some(v)
+	})
+}
+
+// Dedup will return an iterator that collapses runs of
+// consecutive equal values into a single value, similar
+// to Unix's uniq. Equal values that are not adjacent are
+// kept.
+func Dedup[T comparable](iter iterator.Iterator[T]) iterator.Iterator[T] {
+	return DedupBy(iter, func(t T) T { return t })
+}
+
+// DedupBy is the same as Dedup, except values are
+// considered equal if key returns the same value for
+// both. The first value of each run is kept.
+func DedupBy[T any, K comparable](iter iterator.Iterator[T], key func(T) K) iterator.Iterator[T] {
+	var previous optional.Option[K]
+
+	return iterator.Func[T](func() optional.Option[T] {
+		for opt := next(iter); opt.IsSome(); opt = next(iter) {
+			k := key(opt.Expect())
+			if !previous.IsSome() || previous.Expect() != k {
+				previous = optional.Some(k)
+				return opt
+			}
+		}
+
+		return optional.None[T]()
+	})
+}
+
+// DistinctLast will collect all values from the provided
+// iterator, returning an iterator on the last occurrence
+// of each distinct value. The last occurrences are kept
+// in their original order, so later values supersede
+// earlier ones. Since a value's last occurrence is not
+// known until the iterator is exhausted, DistinctLast
+// fully consumes the provided iterator.
+func DistinctLast[T comparable](iter iterator.Iterator[T]) iterator.Iterator[T] {
+	values := Collect(iter)
+	seen := make(map[T]struct{}, len(values))
+
+	distinct := len(values)
+	for i := len(values) - 1; i >= 0; i-- {
+		if _, ok := seen[values[i]]; !ok {
+			seen[values[i]] = struct{}{}
+			distinct--
+			values[distinct] = values[i]
+		}
+	}
+
+	return &iterator.Slice[T]{Values: values[distinct:]}
+}
+
+// Drain will call Next() until None is encountered,
+// discarding the values and returning how many were
+// retrieved. If the iterator implements iterator.Closer,
+// Drain will then close the iterator and return the
+// resulting error. If iter is nil, Drain returns 0.
+func Drain[T any](iter iterator.Iterator[T]) (int, error) {
+	count := Count(iter)
+
+	if closer, ok := iter.(iterator.Closer); ok {
+		return count, closer.Close()
+	}
+
+	return count, nil
+}
+
+// Equal will check if two iterators are equal by pulling
+// from both iterators in lockstep and comparing their values.
+// Equal returns false on the first mismatched value, or if
+// one iterator is exhausted before the other.
+func Equal[T comparable](a, b iterator.Iterator[T]) bool {
+	return EqualBy(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqualBy will check if two iterators are equal by pulling
+// from both iterators in lockstep, comparing their values
+// with eq. EqualBy returns false on the first pair of values
+// for which eq returns false, or if one iterator is exhausted
+// before the other.
+func EqualBy[T any](a, b iterator.Iterator[T], eq func(T, T) bool) bool {
+	// Preliminary check on length to avoid iterating
+	// at all if possible
+	sizedA, okA := a.(iterator.Enumerable[T])
+	sizedB, okB := b.(iterator.Enumerable[T])
+	if okA && okB && sizedA.Count() != sizedB.Count() {
 		return false
 	}
 
-	for idx := 0; idx < len(aValues); idx++ {
-		if aValues[idx] != bValues[idx] {
+	for {
+		x, y := next(a), next(b)
+		if x.IsSome() != y.IsSome() {
 			return false
 		}
-	}
 
-	return true
+		if !x.IsSome() {
+			return true
+		}
+
+		if !eq(x.Expect(), y.Expect()) {
+			return false
+		}
+	}
 }
 
 // Filter will return an iterator with every value "x" in
-// the given iterator such that fn(x) holds true.
+// the given iterator such that fn(x) holds true. Values are
+// filtered lazily as they are retrieved from the returned
+// iterator.
+//
+// Since the number of values that will hold true is unknown,
+// the returned iterator does not implement Enumerable, even
+// if the given iterator does.
 func Filter[T any](iter iterator.Iterator[T], fn func(T) bool) iterator.Iterator[T] {
-	filtered := iterator.Slice[T]{Values: allocate[T](iter)}
-	ForEach(iter, func(t T, _ Break) {
-		if fn(t) {
-			filtered.Values = append(filtered.Values, t)
+	return filtered[T]{source: iter, fn: fn}
+}
+
+// FilterMap will return an iterator containing the values
+// of every Some returned from invoking fn for each value
+// of the provided iterator. Values for which fn returns
+// None are skipped.
+func FilterMap[From, To any](iter iterator.Iterator[From], fn func(From) optional.Option[To]) iterator.Iterator[To] {
+	return iterator.Func[To](func() optional.Option[To] {
+		for opt := next(iter); opt.IsSome(); opt = next(iter) {
+			if mapped := fn(opt.Expect()); mapped.IsSome() {
+				return mapped
+			}
 		}
+
+		return optional.None[To]()
 	})
+}
 
-	return &filtered
+// ErrNoFixedPoint is returned from FixedPoint and FixedPointBy
+// when no fixed point is reached within the maximum number of
+// iterations.
+var ErrNoFixedPoint = errors.New("functional: no fixed point reached")
+
+// FixedPoint will repeatedly apply fn, starting with initial,
+// until fn returns its argument unchanged. The unchanged value
+// is returned as OK. If fn is applied maxIter times without
+// reaching a fixed point, ErrNoFixedPoint is returned.
+func FixedPoint[T comparable](initial T, fn func(T) T, maxIter int) optional.Result[T] {
+	return FixedPointBy(initial, fn, func(a, b T) bool { return a == b }, maxIter)
+}
+
+// FixedPointBy is the same as FixedPoint, except values are
+// compared with eq.
+func FixedPointBy[T any](initial T, fn func(T) T, eq func(T, T) bool, maxIter int) optional.Result[T] {
+	current := initial
+	for i := 0; i < maxIter; i++ {
+		applied := fn(current)
+		if eq(current, applied) {
+			return optional.Ok(applied)
+		}
+
+		current = applied
+	}
+
+	return optional.Err[T](ErrNoFixedPoint)
 }
 
 // ForEach will call the provided function with each element
@@ -136,15 +723,261 @@ func ForEach[T any](iter iterator.Iterator[T], fn func(T, Break)) {
 	}
 }
 
+// ForEachErr will call fn with each element returned from
+// Next(), stopping iteration at the first non-nil error,
+// which is returned. If iter is nil, nil is returned.
+func ForEachErr[T any](iter iterator.Iterator[T], fn func(T) error) error {
+	var err error
+	ForEach(iter, func(t T, stop Break) {
+		if err = fn(t); err != nil {
+			stop()
+		}
+	})
+
+	return err
+}
+
+// GroupConsecutive will return an iterator on runs of adjacent
+// values of the provided iterator sharing the same key, paired
+// with that key. Unlike grouping the entire iterator, only the
+// current run is buffered; values sharing a key that are not
+// adjacent are placed in separate groups.
+func GroupConsecutive[T any, K comparable](iter iterator.Iterator[T], keyFn func(T) K) iterator.Iterator[optional.Pair[K, []T]] {
+	var pending optional.Option[optional.Pair[K, T]]
+	keyed := func(opt optional.Option[T]) optional.Option[optional.Pair[K, T]] {
+		if opt.IsNone() {
+			return optional.None[optional.Pair[K, T]]()
+		}
+
+		return optional.Some(optional.Pair[K, T]{First: keyFn(opt.Expect()), Second: opt.Expect()})
+	}
+
+	return iterator.Func[optional.Pair[K, []T]](func() optional.Option[optional.Pair[K, []T]] {
+		first := pending
+		if first.IsNone() {
+			first = keyed(next(iter))
+		}
+
+		if first.IsNone() {
+			return optional.None[optional.Pair[K, []T]]()
+		}
+
+		key, group := first.Expect().First, []T{first.Expect().Second}
+		for pending = keyed(next(iter)); pending.IsSome(); pending = keyed(next(iter)) {
+			if pending.Expect().First != key {
+				break
+			}
+
+			group = append(group, pending.Expect().Second)
+		}
+
+		return optional.Some(optional.Pair[K, []T]{First: key, Second: group})
+	})
+}
+
+// Intersperse will return an iterator that yields sep
+// between each pair of values in the provided iterator.
+// No separator is yielded before the first value or after
+// the last value.
+func Intersperse[T any](iter iterator.Iterator[T], sep T) iterator.Iterator[T] {
+	var pending optional.Option[T]
+	started := false
+
+	return iterator.Func[T](func() optional.Option[T] {
+		if pending.IsSome() {
+			v := pending
+			pending = optional.None[T]()
+
+			return v
+		}
+
+		opt := next(iter)
+		if !opt.IsSome() || !started {
+			started = true
+			return opt
+		}
+
+		pending = opt
+		return optional.Some(sep)
+	})
+}
+
+// Join will concatenate the strings of the provided iterator,
+// placing sep between each string. The iterator's size hint
+// is used to estimate the capacity of the result.
+func Join(iter iterator.Iterator[string], sep string) string {
+	var builder strings.Builder
+	builder.Grow(getSizeHint(iter) * (len(sep) + 1))
+
+	first := true
+	ForEach(iter, func(s string, _ Break) {
+		if !first {
+			builder.WriteString(sep)
+		}
+
+		first = false
+		builder.WriteString(s)
+	})
+
+	return builder.String()
+}
+
+// LastN will drain the provided iterator, returning its last
+// n values in order. If the iterator has fewer than n values,
+// all of its values are returned. Only the last n values are
+// kept while draining, so memory use is bounded by n rather
+// than the length of the iterator. If n is negative, LastN
+// will panic.
+func LastN[T any](iter iterator.Iterator[T], n int) []T {
+	if n < 0 {
+		bork("negative count %d passed to last n", n)
+	}
+
+	var ring []T
+	start := 0
+	ForEach(iter, func(t T, _ Break) {
+		if len(ring) < n {
+			ring = append(ring, t)
+		} else if n > 0 {
+			ring[start] = t
+			start = (start + 1) % n
+		}
+	})
+
+	return append(append(make([]T, 0, len(ring)), ring[start:]...), ring[:start]...)
+}
+
 // Map will return an iterator containing the results of
-// invoking fn for each value of the provided iterator.
+// invoking fn for each value of the provided iterator. Values
+// are mapped lazily as they are retrieved from the returned
+// iterator. In particular, mapping an iterator.Chan returns
+// immediately, and each value is mapped as it is received,
+// even if the channel never closes.
+//
+// If the given iterator implements Enumerable, so does the
+// returned iterator, with the same count.
 func Map[From, To any](iter iterator.Iterator[From], fn func(From) To) iterator.Iterator[To] {
-	mapped := iterator.Slice[To]{Values: allocate[To](iter)}
-	ForEach(iter, func(x From, _ Break) {
-		mapped.Values = append(mapped.Values, fn(x))
+	m := mapped[From, To]{source: iter, fn: fn}
+	if _, ok := iter.(iterator.Enumerable[From]); ok {
+		return sizedMapped[From, To]{m}
+	}
+
+	return m
+}
+
+// MapWhile will return an iterator containing the values of
+// invoking fn for each value of the provided iterator, until
+// fn returns None. Once fn returns None, the returned iterator
+// is exhausted and the provided iterator is no longer pulled.
+func MapWhile[From, To any](iter iterator.Iterator[From], fn func(From) optional.Option[To]) iterator.Iterator[To] {
+	done := false
+
+	return iterator.Func[To](func() optional.Option[To] {
+		if done {
+			return optional.None[To]()
+		}
+
+		if opt := next(iter); opt.IsSome() {
+			if mapped := fn(opt.Expect()); mapped.IsSome() {
+				return mapped
+			}
+		}
+
+		done = true
+		return optional.None[To]()
+	})
+}
+
+// MergeSorted will return an iterator performing a k-way
+// merge of the provided iterators, each of which is assumed
+// to already be sorted according to less. If an iterator is
+// not sorted, the result is unspecified. Values that are
+// equal are yielded in the order of their iterators'
+// positions in the argument list.
+//
+// The first value of each iterator is retrieved on the first
+// call to Next(). Afterwards, only the iterator whose value
+// was yielded is advanced.
+func MergeSorted[T any](less func(a, b T) bool, iters ...iterator.Iterator[T]) iterator.Iterator[T] {
+	var h *mergeHeap[T]
+
+	return iterator.Func[T](func() optional.Option[T] {
+		if h == nil {
+			h = &mergeHeap[T]{heads: make([]mergeHead[T], 0, len(iters)), less: less}
+			for position, iter := range iters {
+				if opt := next(iter); opt.IsSome() {
+					h.heads = append(h.heads, mergeHead[T]{opt.Expect(), iter, position})
+				}
+			}
+			heap.Init(h)
+		}
+
+		if h.Len() == 0 {
+			return optional.None[T]()
+		}
+
+		head := &h.heads[0]
+		v := head.value
+		if opt := head.source.Next(); opt.IsSome() {
+			head.value = opt.Expect()
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+
+		return optional.Some(v)
+	})
+}
+
+// Nth will return the n-th value (starting from 0) of the
+// provided iterator, or None if the iterator has n or fewer
+// values. At most n + 1 values are retrieved. If n is
+// negative, Nth will panic.
+func Nth[T any](iter iterator.Iterator[T], n int) optional.Option[T] {
+	if n < 0 {
+		bork("negative index %d passed to nth", n)
+	}
+
+	for idx := 0; ; idx++ {
+		if opt := next(iter); !opt.IsSome() || idx == n {
+			return opt
+		}
+	}
+}
+
+// PartitionIter will lazily split the provided iterator into
+// two iterators: one containing every value "x" such that
+// pred(x) holds true, and one containing the rest.
+//
+// Both iterators share the provided iterator. When one is
+// advanced, values from the source are pulled until one
+// belonging to that iterator is found; any values pulled
+// along the way are buffered for the other iterator. As
+// such, consuming one iterator far ahead of the other may
+// buffer an unbounded number of values.
+//
+// The returned iterators are not safe for concurrent use.
+func PartitionIter[T any](iter iterator.Iterator[T], pred func(T) bool) (matched, unmatched iterator.Iterator[T]) {
+	p := &partition[T]{source: iter, pred: pred}
+
+	return iterator.Func[T](func() optional.Option[T] { return p.next(true) }),
+		iterator.Func[T](func() optional.Option[T] { return p.next(false) })
+}
+
+// PartitionOptions will drain the provided iterator, returning
+// the values of its Some options in order along with the number
+// of None options encountered. The returned slice is not nil.
+func PartitionOptions[T any](iter iterator.Iterator[optional.Option[T]]) (values []T, noneCount int) {
+	values = allocate[T](iter)
+	ForEach(iter, func(opt optional.Option[T], _ Break) {
+		if opt.IsSome() {
+			values = append(values, opt.Expect())
+		} else {
+			noneCount++
+		}
 	})
 
-	return &mapped
+	return values, noneCount
 }
 
 // Reduce will invoke the provided function on each element
@@ -165,6 +998,170 @@ func Reduce[From, To any](iter iterator.Iterator[From], fn func(accum To, cur Fr
 	return accumulator
 }
 
+// Reduce1 is the same as Reduce, except the first value
+// of the iterator is used as the initial accumulated value.
+// If the iterator is empty, None is returned.
+func Reduce1[T any](iter iterator.Iterator[T], fn func(T, T) T) optional.Option[T] {
+	var accumulator optional.Option[T]
+	ForEach(iter, func(x T, _ Break) {
+		if accumulator.IsSome() {
+			accumulator = optional.Some(fn(accumulator.Expect(), x))
+		} else {
+			accumulator = optional.Some(x)
+		}
+	})
+
+	return accumulator
+}
+
+// ReduceContext is the same as Reduce, except the accumulated
+// value starts as initial and the context is checked between
+// values. If the iterator implements BlockingIterator, values
+// are retrieved with its WaitForNext method, so waiting is
+// interrupted once ctx is canceled; otherwise, Next() is
+// called directly. On cancellation, the value accumulated so
+// far is returned alongside the context's error.
+func ReduceContext[From, To any](ctx context.Context, iter iterator.Iterator[From], initial To, fn func(To, From) To) (To, error) {
+	accumulator := initial
+	if iter == nil {
+		return accumulator, ctx.Err()
+	}
+
+	blocking, isBlocking := iter.(iterator.BlockingIterator[From])
+	for {
+		if err := ctx.Err(); err != nil {
+			return accumulator, err
+		}
+
+		var opt optional.Option[From]
+		if isBlocking {
+			opt = blocking.WaitForNext(ctx)
+		} else {
+			opt = iter.Next()
+		}
+
+		if opt.IsNone() && isBlocking {
+			return accumulator, ctx.Err()
+		} else if opt.IsNone() {
+			return accumulator, nil
+		}
+
+		accumulator = fn(accumulator, opt.Expect())
+	}
+}
+
+// ReduceInPlace is the same as Reduce, except the accumulator
+// is updated through a pointer rather than copied for each
+// value. This is preferable when the accumulator is expensive
+// to copy, such as a large struct. acc must not be nil; if it
+// is, ReduceInPlace will panic.
+func ReduceInPlace[From, Acc any](iter iterator.Iterator[From], acc *Acc, fn func(acc *Acc, cur From)) {
+	if acc == nil {
+		bork("nil accumulator passed to reduce in place")
+	}
+
+	ForEach(iter, func(x From, _ Break) {
+		fn(acc, x)
+	})
+}
+
+// ReduceWindows will return an iterator containing the
+// results of invoking reducer on each consecutive,
+// non-overlapping chunk of size values of the provided
+// iterator. If the number of values is not a multiple of
+// size, the final chunk is shorter. If size is not positive,
+// ReduceWindows will panic.
+func ReduceWindows[T, R any](iter iterator.Iterator[T], size int, reducer func([]T) R) iterator.Iterator[R] {
+	if size <= 0 {
+		bork("non-positive window size %d passed to reduce windows", size)
+	}
+
+	return iterator.Func[R](func() optional.Option[R] {
+		if window := take(iter, make([]T, 0, size), size); len(window) > 0 {
+			return optional.Some(reducer(window))
+		}
+
+		return optional.None[R]()
+	})
+}
+
+// ResultChain will compose the provided fallible functions
+// from left to right, like ChainUntil. The returned function
+// stops at the first erroneous result and returns it, skipping
+// the remaining functions. If no functions are provided, the
+// returned function returns its argument as OK. If any function
+// is nil, ResultChain will panic.
+func ResultChain[T any](fns ...func(T) optional.Result[T]) func(T) optional.Result[T] {
+	for idx, fn := range fns {
+		if fn == nil {
+			bork("nil function at index %d of result chain", idx)
+		}
+	}
+
+	return func(t T) optional.Result[T] {
+		result := optional.Ok(t)
+		for _, fn := range fns {
+			if result = fn(result.Expect()); !result.Ok() {
+				break
+			}
+		}
+
+		return result
+	}
+}
+
+// SlidingWindow will return an iterator on windows of the
+// provided size, where the start of each window is step
+// values after the start of the previous window. If step is
+// less than size, windows overlap; if step equals size, the
+// values are chunked; if step is greater than size, values
+// between windows are skipped. The final window may contain
+// fewer than size values, but only if it contains a value
+// absent from the previous window.
+//
+// If size or step are not positive, SlidingWindow will panic.
+func SlidingWindow[T any](iter iterator.Iterator[T], size, step int) iterator.Iterator[[]T] {
+	if size <= 0 {
+		bork("non-positive window size %d passed to sliding window", size)
+	}
+
+	if step <= 0 {
+		bork("non-positive step %d passed to sliding window", step)
+	}
+
+	var previous []T
+	done := false
+
+	return iterator.Func[[]T](func() optional.Option[[]T] {
+		if done {
+			return optional.None[[]T]()
+		}
+
+		window := make([]T, 0, size)
+		if previous != nil && step < size {
+			window = append(window, previous[step:]...)
+		} else if previous != nil {
+			for skip := step - size; skip > 0; skip-- {
+				if next(iter).IsNone() {
+					done = true
+					return optional.None[[]T]()
+				}
+			}
+		}
+
+		carried := len(window)
+		if window = take(iter, window, size-carried); len(window) == carried {
+			done = true
+			return optional.None[[]T]()
+		}
+
+		done = len(window) < size
+		previous = window
+
+		return optional.Some(window)
+	})
+}
+
 // Sort will sort the provided iterator if it is not already sorted.
 // If stable is set to true, the iterator will be sorted via sort.Stable.
 // Otherwise, sort.Sort will be used.
@@ -191,6 +1188,248 @@ func Sort[T Comparable](iter iterator.Iterator[T], stable bool) iterator.Iterato
 	return sorted[T]{&iterator.Slice[T]{Values: []T(values)}}
 }
 
+// SortStableBy will collect all values from the provided
+// iterator and sort them using less via sort.SliceStable,
+// so equal values retain their original order.
+func SortStableBy[T any](iter iterator.Iterator[T], less func(a, b T) bool) iterator.Iterator[T] {
+	values := Collect(iter)
+	sort.SliceStable(values, func(i, j int) bool { return less(values[i], values[j]) })
+
+	return &iterator.Slice[T]{Values: values}
+}
+
+// SplitAt will collect the first n values of the provided
+// iterator into a slice, returning it along with the provided
+// iterator, which continues with the remaining values. The
+// remaining values are not retrieved. If n is negative,
+// SplitAt will panic.
+func SplitAt[T any](iter iterator.Iterator[T], n int) ([]T, iterator.Iterator[T]) {
+	if n < 0 {
+		bork("negative count %d passed to split at", n)
+	}
+
+	size := getSizeHint(iter)
+	if n < size {
+		size = n
+	}
+
+	return take(iter, make([]T, 0, size), n), iter
+}
+
+// SplitBy will return an iterator of slices, each containing
+// a run of consecutive values for which isDelimiter does not
+// hold true. Delimiters are dropped. Since empty runs are
+// omitted, leading, trailing, and consecutive delimiters do
+// not produce empty slices.
+func SplitBy[T any](iter iterator.Iterator[T], isDelimiter func(T) bool) iterator.Iterator[[]T] {
+	return iterator.Func[[]T](func() optional.Option[[]T] {
+		var segment []T
+		for opt := next(iter); opt.IsSome(); opt = next(iter) {
+			if !isDelimiter(opt.Expect()) {
+				segment = append(segment, opt.Expect())
+			} else if len(segment) > 0 {
+				return optional.Some(segment)
+			}
+		}
+
+		if len(segment) > 0 {
+			return optional.Some(segment)
+		}
+
+		return optional.None[[]T]()
+	})
+}
+
+// Start will return a Composer whose function returns its
+// argument unchanged. Steps may be added with Then.
+func Start[A any]() Composer[A, A] {
+	return Composer[A, A]{fn: func(a A) A { return a }}
+}
+
+// StartsWith will return whether the provided iterator begins
+// with the values of prefix. StartsWith only pulls as many
+// values from iter as needed: it stops on the first mismatch
+// or once prefix is exhausted. An empty prefix always matches.
+func StartsWith[T comparable](iter, prefix iterator.Iterator[T]) bool {
+	return All(prefix, func(p T) bool {
+		opt := next(iter)
+		return opt.IsSome() && opt.Expect() == p
+	})
+}
+
+// Then will return a Composer whose function calls fn on the
+// result of the provided Composer's function. If fn is nil,
+// Then will panic.
+func Then[A, B, C any](c Composer[A, B], fn func(B) C) Composer[A, C] {
+	if fn == nil {
+		bork("nil function passed to then")
+	}
+
+	previous := c.fn
+	return Composer[A, C]{fn: func(a A) C { return fn(previous(a)) }}
+}
+
+// Timeout will call fn on a separate Goroutine, returning
+// its result if fn returns within the provided duration.
+// Otherwise, None is returned.
+//
+// If fn does not return in time, the Goroutine is not
+// stopped - it is "leaked" until fn returns, at which point
+// the result is discarded.
+func Timeout[T any](d time.Duration, fn func() T) optional.Option[T] {
+	ch := make(chan T, 1)
+	go func() { ch <- fn() }()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case t := <-ch:
+		return optional.Some(t)
+	case <-timer.C:
+	}
+
+	return optional.None[T]()
+}
+
+// TryReduce is the same as Reduce, except the accumulator
+// starts as initial and fn may fail. If fn returns an
+// erroneous result, TryReduce stops iterating and returns
+// that result. Otherwise, the final accumulated value is
+// returned as OK.
+func TryReduce[From, To any](iter iterator.Iterator[From], initial To, fn func(To, From) optional.Result[To]) optional.Result[To] {
+	accumulator := optional.Ok(initial)
+	ForEach(iter, func(x From, stop Break) {
+		if accumulator = fn(accumulator.Expect(), x); !accumulator.Ok() {
+			stop()
+		}
+	})
+
+	return accumulator
+}
+
+// ValidateChunks will call validate on chunks of the provided
+// iterator's values, each containing size values except for
+// possibly the last. If validate returns an error, ValidateChunks
+// stops iterating and returns the error. Otherwise, the number
+// of values validated is returned as OK. Chunks share the same
+// backing array, so validate should not retain them.
+//
+// If size is not positive, ValidateChunks will panic.
+func ValidateChunks[T any](iter iterator.Iterator[T], size int, validate func([]T) error) optional.Result[int] {
+	if size <= 0 {
+		bork("non-positive chunk size %d passed to validate chunks", size)
+	}
+
+	count := 0
+	for chunk := take(iter, make([]T, 0, size), size); len(chunk) > 0; chunk = take(iter, chunk[:0], size) {
+		if err := validate(chunk); err != nil {
+			return optional.Err[int](err)
+		}
+
+		count += len(chunk)
+	}
+
+	return optional.Ok(count)
+}
+
+// WithDefault will return an iterator on the values of the
+// provided iterator. If the provided iterator has no values,
+// the returned iterator yields def once instead. The source
+// is not checked for values until Next() is first called.
+func WithDefault[T any](iter iterator.Iterator[T], def T) iterator.Iterator[T] {
+	started := false
+
+	return iterator.Func[T](func() optional.Option[T] {
+		opt := next(iter)
+		if !started && opt.IsNone() {
+			opt = optional.Some(def)
+		}
+
+		started = true
+		return opt
+	})
+}
+
+// Zip will return an iterator pairing the values of both
+// iterators, stopping once either iterator is exhausted.
+// Iterators are pulled in argument order, so if a is longer
+// than b, one extra value will be pulled from a.
+func Zip[A, B any](a iterator.Iterator[A], b iterator.Iterator[B]) iterator.Iterator[optional.Pair[A, B]] {
+	return iterator.Func[optional.Pair[A, B]](func() optional.Option[optional.Pair[A, B]] {
+		x := next(a)
+		if !x.IsSome() {
+			return optional.None[optional.Pair[A, B]]()
+		}
+
+		return optional.Zip(x, next(b))
+	})
+}
+
+// Zip3 is the same as Zip, except it combines three
+// iterators instead of two.
+func Zip3[A, B, C any](
+	a iterator.Iterator[A],
+	b iterator.Iterator[B],
+	c iterator.Iterator[C],
+) iterator.Iterator[optional.Triple[A, B, C]] {
+	return iterator.Func[optional.Triple[A, B, C]](func() optional.Option[optional.Triple[A, B, C]] {
+		x := next(a)
+		if !x.IsSome() {
+			return optional.None[optional.Triple[A, B, C]]()
+		}
+
+		y := next(b)
+		if !y.IsSome() {
+			return optional.None[optional.Triple[A, B, C]]()
+		}
+
+		return optional.Zip3(x, y, next(c))
+	})
+}
+
+// ZipSlices is the same as Zip, except it combines any
+// number of iterators of the same type, yielding a slice
+// containing one value from each iterator. If no iterators
+// are provided, the returned iterator is exhausted.
+func ZipSlices[T any](iters ...iterator.Iterator[T]) iterator.Iterator[[]T] {
+	return iterator.Func[[]T](func() optional.Option[[]T] {
+		if len(iters) == 0 {
+			return optional.None[[]T]()
+		}
+
+		values := make([]T, len(iters))
+		for idx, iter := range iters {
+			opt := next(iter)
+			if !opt.IsSome() {
+				return optional.None[[]T]()
+			}
+
+			values[idx] = opt.Expect()
+		}
+
+		return optional.Some(values)
+	})
+}
+
+// ZipWith is the same as Zip, except corresponding values
+// are combined with fn rather than paired.
+func ZipWith[A, B, C any](a iterator.Iterator[A], b iterator.Iterator[B], fn func(A, B) C) iterator.Iterator[C] {
+	return iterator.Func[C](func() optional.Option[C] {
+		x := next(a)
+		if !x.IsSome() {
+			return optional.None[C]()
+		}
+
+		y := next(b)
+		if !y.IsSome() {
+			return optional.None[C]()
+		}
+
+		return optional.Some(fn(x.Expect(), y.Expect()))
+	})
+}
+
 // allocate will allocate a slice with some backing memory (not
 // zeroed) equal to the size of the provided iterator's count
 // if the iterator implements Enumerable.
@@ -198,19 +1437,94 @@ func allocate[T, Source any](iter iterator.Iterator[Source]) []T {
 	return make([]T, 0, getSizeHint(iter))
 }
 
+// bork will panic with the provided message, formatted
+// using fmt.Sprintf and prefixed with the package name.
+func bork(format string, args ...any) {
+	panic(fmt.Sprintf("functional: "+format, args...))
+}
+
+// countUpTo will count the values "x" of the provided
+// iterator such that pred(x) holds true, stopping once
+// the count reaches limit.
+func countUpTo[T any](iter iterator.Iterator[T], pred func(T) bool, limit int) int {
+	if limit <= 0 {
+		return 0
+	}
+
+	count := 0
+	ForEach(iter, func(t T, stop Break) {
+		if pred(t) {
+			if count++; count >= limit {
+				stop()
+			}
+		}
+	})
+
+	return count
+}
+
 // getSizeHint will return iter.Count() if iter implements
-// Enumerable. Otherwise, getSizedHint will return a default.
+// Enumerable, or the iterator's own estimate if it implements
+// sizeHinter. Otherwise, getSizedHint will return a default.
 func getSizeHint[T any](iter iterator.Iterator[T]) int {
 	const defaultSize = 16
 	if sized, ok := iter.(iterator.Enumerable[T]); ok {
 		if count := sized.Count(); count > 0 {
 			return count
 		}
+	} else if hinted, ok := iter.(sizeHinter); ok {
+		if hint := hinted.sizeHint(); hint > 0 {
+			return hint
+		}
 	}
 
 	return defaultSize
 }
 
+// poolOf will return the pool of slices of T used by
+// CollectPooled, creating it if necessary.
+func poolOf[T any]() *sync.Pool {
+	key := reflect.TypeOf((*T)(nil))
+	if pool, ok := pools.Load(key); ok {
+		return pool.(*sync.Pool)
+	}
+
+	pool, _ := pools.LoadOrStore(key, &sync.Pool{
+		New: func() any { return new([]T) },
+	})
+
+	return pool.(*sync.Pool)
+}
+
+// take will append up to n values retrieved from iter to
+// buffer, returning the extended buffer.
+func take[T any](iter iterator.Iterator[T], buffer []T, n int) []T {
+	for ; n > 0; n-- {
+		opt := next(iter)
+		if !opt.IsSome() {
+			break
+		}
+
+		buffer = append(buffer, opt.Expect())
+	}
+
+	return buffer
+}
+
+// next will return iter.Next(), or None if iter is nil.
+func next[T any](iter iterator.Iterator[T]) optional.Option[T] {
+	if iter == nil {
+		return optional.None[T]()
+	}
+
+	return iter.Next()
+}
+
+// Build will return the composed function.
+func (c Composer[A, B]) Build() func(A) B {
+	return c.fn
+}
+
 func (array comparables[T]) Len() int {
 	return len(array)
 }
@@ -224,3 +1538,90 @@ func (array comparables[T]) Swap(i, j int) {
 	array[i] = array[j]
 	array[j] = tmp
 }
+
+func (f filtered[T]) Next() optional.Option[T] {
+	for opt := next(f.source); opt.IsSome(); opt = next(f.source) {
+		if f.fn(opt.Expect()) {
+			return opt
+		}
+	}
+
+	return optional.None[T]()
+}
+
+// sizeHint will return the size hint of the source iterator,
+// which is an upper bound on the number of remaining values.
+func (f filtered[T]) sizeHint() int {
+	return getSizeHint(f.source)
+}
+
+func (m mapped[From, To]) Next() optional.Option[To] {
+	if opt := next(m.source); opt.IsSome() {
+		return optional.Some(m.fn(opt.Expect()))
+	}
+
+	return optional.None[To]()
+}
+
+func (m sizedMapped[From, To]) Count() int {
+	return m.source.(iterator.Enumerable[From]).Count()
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.heads)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	a, b := h.heads[i], h.heads[j]
+	if h.less(a.value, b.value) {
+		return true
+	}
+
+	return !h.less(b.value, a.value) && a.position < b.position
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.heads[i], h.heads[j] = h.heads[j], h.heads[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+	h.heads = append(h.heads, x.(mergeHead[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	last := h.heads[len(h.heads)-1]
+	h.heads = h.heads[:len(h.heads)-1]
+
+	return last
+}
+
+// next will return the next value belonging to the matched
+// branch if want is true, or the unmatched branch otherwise.
+func (p *partition[T]) next(want bool) optional.Option[T] {
+	buffer, other := &p.unmatched, &p.matched
+	if want {
+		buffer, other = other, buffer
+	}
+
+	if len(*buffer) > 0 {
+		v := (*buffer)[0]
+		*buffer = (*buffer)[1:]
+
+		return optional.Some(v)
+	}
+
+	if p.source == nil {
+		return optional.None[T]()
+	}
+
+	for opt := p.source.Next(); opt.IsSome(); opt = p.source.Next() {
+		v := opt.Expect()
+		if p.pred(v) == want {
+			return opt
+		}
+
+		*other = append(*other, v)
+	}
+
+	return optional.None[T]()
+}
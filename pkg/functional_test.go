@@ -1,8 +1,14 @@
 package functional_test
 
 import (
+	"context"
+	"errors"
+	"math"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	functional "github.com/standoffvenus/functional/v2/pkg"
 	"github.com/standoffvenus/functional/v2/pkg/iterator"
@@ -14,6 +20,24 @@ func GreaterThan0(x int) bool { return x > 0 }
 
 type Int int
 
+type closingIterator struct {
+	iterator.Slice[int]
+	closed bool
+	err    error
+}
+
+func TestAggregate(t *testing.T) {
+	type SumCount struct{ Sum, Count int }
+	average := functional.Aggregate(
+		Iterator(1, 2, 3, 4),
+		SumCount{},
+		func(acc SumCount, x int) SumCount { return SumCount{acc.Sum + x, acc.Count + 1} },
+		func(acc SumCount) float64 { return float64(acc.Sum) / float64(acc.Count) },
+	)
+
+	assert.Equal(t, 2.5, average)
+}
+
 func TestAllWithAllTrue(t *testing.T) {
 	iter := Iterator(1, 2, 3)
 	assert.True(t, functional.All(iter, GreaterThan0))
@@ -42,6 +66,155 @@ func TestAnyWithNoValues(t *testing.T) {
 	assert.False(t, functional.Any(Iterator[int](), GreaterThan0))
 }
 
+func TestAtLeast(t *testing.T) {
+	iter := Iterator(1, -1, 2, 3, 4)
+
+	assert.True(t, functional.AtLeast(iter, GreaterThan0, 2))
+	AssertIteratorEqual(t, []int{3, 4}, iter)
+}
+
+func TestAtLeastNotEnough(t *testing.T) {
+	assert.False(t, functional.AtLeast(Iterator(1, -1, 0), GreaterThan0, 2))
+}
+
+func TestAtLeastNonPositive(t *testing.T) {
+	iter := Iterator(-1)
+
+	assert.True(t, functional.AtLeast(iter, GreaterThan0, 0))
+	AssertIteratorEqual(t, []int{-1}, iter)
+}
+
+func TestAtMost(t *testing.T) {
+	assert.True(t, functional.AtMost(Iterator(1, -1, 2), GreaterThan0, 2))
+}
+
+func TestAtMostTooMany(t *testing.T) {
+	iter := Iterator(1, 2, 3, 4)
+
+	assert.False(t, functional.AtMost(iter, GreaterThan0, 2))
+	AssertIteratorEqual(t, []int{4}, iter)
+}
+
+func TestBuildString(t *testing.T) {
+	type Row struct {
+		Name string
+		Age  int
+	}
+
+	iter := &iterator.Slice[Row]{Values: []Row{{"Ann", 31}, {"Bo", 4}}}
+	csv := functional.BuildString[Row](iter, func(b *strings.Builder, r Row) {
+		b.WriteString(r.Name)
+		b.WriteByte(',')
+		b.WriteString(strconv.Itoa(r.Age))
+		b.WriteByte('\n')
+	})
+
+	assert.Equal(t, "Ann,31\nBo,4\n", csv)
+}
+
+func TestBuildStringNoValues(t *testing.T) {
+	assert.Equal(t, "", functional.BuildString(Iterator[int](), func(*strings.Builder, int) {}))
+}
+
+func TestChain(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+	increment := func(x int) int { return x + 1 }
+
+	assert.Equal(t, 7, functional.Chain(increment, double)(3))
+	assert.Equal(t, 8, functional.Chain(double, increment)(3))
+}
+
+func TestChainNoFunctions(t *testing.T) {
+	assert.Equal(t, 42, functional.Chain[int]()(42))
+}
+
+func TestChainPanicsOnNilFunction(t *testing.T) {
+	assert.Panics(t, func() { functional.Chain[int](nil) })
+}
+
+func TestChainIter(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+	increment := func(x int) int { return x + 1 }
+	fns := iterator.Chan[func(int) int](iterator.SendTo(increment, double))
+
+	assert.Equal(t, 7, functional.ChainIter[int](fns)(3))
+}
+
+func TestChainIterPanicsOnNilFunction(t *testing.T) {
+	fns := Iterator[func(int) int](nil)
+	assert.Panics(t, func() { functional.ChainIter(fns) })
+}
+
+func TestChainUntil(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+	increment := func(x int) int { return x + 1 }
+	atLeast10 := func(x int) bool { return x >= 10 }
+
+	assert.Equal(t, 7, functional.ChainUntil(atLeast10, double, increment)(3))
+	assert.Equal(t, 10, functional.ChainUntil(atLeast10, double, increment, double)(5))
+	assert.Equal(t, 12, functional.ChainUntil(atLeast10, double, increment)(12))
+}
+
+func TestChainUntilPanicsOnNilFunction(t *testing.T) {
+	assert.Panics(t, func() { functional.ChainUntil(GreaterThan0, nil) })
+}
+
+func TestChunkExact(t *testing.T) {
+	iter := Iterator(1, 2, 3, 4, 5)
+	chunks := functional.ChunkExact(iter, 2)
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, functional.Collect(chunks))
+	assert.False(t, iter.Next().IsSome())
+}
+
+func TestChunkExactMultiple(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 2, 3}}, functional.Collect(functional.ChunkExact(Iterator(1, 2, 3), 3)))
+}
+
+func TestChunkExactPanicsOnNonPositiveSize(t *testing.T) {
+	assert.Panics(t, func() { functional.ChunkExact(Iterator(1), 0) })
+}
+
+func TestChunkReuse(t *testing.T) {
+	chunks := functional.ChunkReuse(Iterator(1, 2, 3, 4, 5), 2)
+
+	var sums []int
+	functional.ForEach(chunks, func(chunk []int, _ functional.Break) {
+		sums = append(sums, functional.Sum[int](&iterator.Slice[int]{Values: chunk}))
+	})
+
+	assert.Equal(t, []int{3, 7, 5}, sums)
+}
+
+func TestChunkReuseSharesBackingArray(t *testing.T) {
+	chunks := functional.ChunkReuse(Iterator(1, 2, 3, 4), 2)
+	first := chunks.Next().Expect()
+	second := chunks.Next().Expect()
+
+	assert.Equal(t, []int{3, 4}, first)
+	assert.Equal(t, []int{3, 4}, second)
+}
+
+func TestChunkReusePanicsOnNonPositiveSize(t *testing.T) {
+	assert.Panics(t, func() { functional.ChunkReuse(Iterator(1), 0) })
+}
+
+func TestChunkToChan(t *testing.T) {
+	chunks := functional.ChunkToChan(Iterator(1, 2, 3, 4, 5), 2)
+
+	AssertEqualChan(t, [][]int{{1, 2}, {3, 4}, {5}}, chunks)
+}
+
+func TestChunkToChanExactMultiple(t *testing.T) {
+	chunks := functional.ChunkToChan(Iterator(1, 2, 3, 4), 2)
+
+	AssertEqualChan(t, [][]int{{1, 2}, {3, 4}}, chunks)
+}
+
+func TestChunkToChanPanicsOnNonPositiveSize(t *testing.T) {
+	assert.Panics(t, func() { functional.ChunkToChan(Iterator(1), 0) })
+}
+
 func TestCollect(t *testing.T) {
 	ints := []int{1, 2, 3}
 	iter := &iterator.Slice[int]{Values: ints}
@@ -50,6 +223,127 @@ func TestCollect(t *testing.T) {
 	assert.Equal(t, ints, collected)
 }
 
+func TestCollectPartiallyConsumedSlice(t *testing.T) {
+	ints := []int{1, 2, 3}
+	iter := &iterator.Slice[int]{Values: ints}
+	_ = iter.Next()
+
+	collected := functional.Collect[int](iter)
+	assert.Equal(t, []int{2, 3}, collected)
+	assert.False(t, iter.Next().IsSome())
+
+	collected[0] = 42
+	assert.Equal(t, []int{1, 2, 3}, ints)
+}
+
+func TestCollectSliceThenAppend(t *testing.T) {
+	ints := []int{1, 2, 3}
+	iter := &iterator.Slice[int]{Values: ints}
+	_ = iter.Next()
+
+	assert.Equal(t, []int{2, 3}, functional.Collect[int](iter))
+	iter.Append(9)
+
+	assert.Equal(t, []int{1, 2, 3}, ints)
+	assert.Equal(t, 9, iter.Next().Expect())
+}
+
+func BenchmarkCollectSlice(b *testing.B) {
+	ints := functional.IntRange(0, 1024, 1)
+	for i := 0; i < b.N; i++ {
+		functional.Collect[int](&iterator.Slice[int]{Values: ints})
+	}
+}
+
+func BenchmarkCollectFunc(b *testing.B) {
+	ints := functional.IntRange(0, 1024, 1)
+	for i := 0; i < b.N; i++ {
+		source := &iterator.Slice[int]{Values: ints}
+		functional.Collect[int](iterator.Func[int](source.Next))
+	}
+}
+
+func TestCollectContext(t *testing.T) {
+	collected, err := functional.CollectContext(context.Background(), Iterator(1, 2, 3))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, collected)
+}
+
+func TestCollectContextReturnsPartialValuesOnCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+
+	collected, err := functional.CollectContext[int](ctx, iterator.Chan[int](ch))
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, []int{1, 2}, collected)
+}
+
+func TestCollectContextDoesNotLoseValuesOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	source := &iterator.Slice[int]{Values: []int{1, 2, 3}}
+	iter := iterator.Func[int](func() optional.Option[int] {
+		opt := source.Next()
+		if opt.Get() == 1 {
+			cancel()
+		}
+
+		return opt
+	})
+
+	collected, err := functional.CollectContext[int](ctx, iter)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []int{1}, collected)
+	assert.Equal(t, 2, iter.Next().Expect())
+}
+
+func TestCollectPooled(t *testing.T) {
+	ints := []int{1, 2, 3}
+	collected, release := functional.CollectPooled[int](&iterator.Slice[int]{Values: ints})
+
+	assert.Equal(t, ints, collected)
+	release()
+	assert.NotPanics(t, release)
+
+	collected, release = functional.CollectPooled[int](&iterator.Slice[int]{Values: ints[:1]})
+	defer release()
+	assert.Equal(t, ints[:1], collected)
+}
+
+func TestCollectPooledDistinctTypes(t *testing.T) {
+	ints, releaseInts := functional.CollectPooled(Iterator(1, 2))
+	defer releaseInts()
+	strs, releaseStrs := functional.CollectPooled(Iterator("a", "b"))
+	defer releaseStrs()
+
+	assert.Equal(t, []int{1, 2}, ints)
+	assert.Equal(t, []string{"a", "b"}, strs)
+}
+
+func TestCollectResults(t *testing.T) {
+	iter := Iterator(optional.Ok(1), optional.Ok(2), optional.Ok(3))
+	collected := functional.CollectResults[int](iter)
+
+	assert.Equal(t, []int{1, 2, 3}, collected.Expect())
+}
+
+func TestCollectResultsStopsOnError(t *testing.T) {
+	var Error error = errors.New("error")
+	iter := &iterator.Slice[optional.Result[int]]{
+		Values: []optional.Result[int]{optional.Ok(1), optional.Err[int](Error), optional.Ok(3)},
+	}
+	collected := functional.CollectResults[int](iter)
+
+	assert.ErrorIs(t, collected.Err(), Error)
+	assert.Equal(t, 1, iter.Count())
+}
+
 func TestCollectToChan(t *testing.T) {
 	ints := []int{1, 2, 3}
 	iter := &iterator.Slice[int]{Values: ints}
@@ -67,6 +361,193 @@ func TestCollectToChanNoDeadlock(t *testing.T) {
 	assert.Equal(t, Value, <-collected)
 }
 
+func TestCollectToChanContext(t *testing.T) {
+	ints := []int{1, 2, 3}
+	iter := &iterator.Slice[int]{Values: ints}
+	collected := functional.CollectToChanContext[int](context.Background(), iter, 0)
+
+	AssertEqualChan(t, ints, collected)
+}
+
+func TestCollectToChanContextPanicsOnNegativeBuffer(t *testing.T) {
+	assert.Panics(t, func() { functional.CollectToChanContext[int](context.Background(), Iterator(1), -1) })
+}
+
+func TestCollectToChanContextStopsOnCancel(t *testing.T) {
+	const Value = 42
+	ctx, cancel := context.WithCancel(context.Background())
+	f := func() optional.Option[int] { return optional.Some(Value) }
+	collected := functional.CollectToChanContext[int](ctx, iterator.Func[int](f), 1)
+
+	assert.Equal(t, Value, <-collected)
+	cancel()
+
+	// Drain whatever may have been buffered; the channel
+	// must close rather than block forever.
+	for range collected {
+	}
+}
+
+func TestCollectToChanContextStopsWaitingOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	collected := functional.CollectToChanContext[int](ctx, iterator.Chan[int](make(chan int)), 0)
+	cancel()
+
+	_, ok := <-collected
+	assert.False(t, ok)
+}
+
+func TestCompactOptions(t *testing.T) {
+	parse := func(s string) optional.Option[int] {
+		if x, err := strconv.Atoi(s); err == nil {
+			return optional.Some(x)
+		}
+
+		return optional.None[int]()
+	}
+
+	parsed := functional.Map(Iterator("1", "x", "3", ""), parse)
+
+	assert.Equal(t, []int{1, 3}, functional.Collect(functional.CompactOptions(parsed)))
+}
+
+func TestComposer(t *testing.T) {
+	length := func(s string) int { return len(s) }
+	double := func(x int) float64 { return float64(x) * 2 }
+
+	fn := functional.Then(functional.Then(functional.Then(
+		functional.Start[int](), strconv.Itoa), length), double).Build()
+
+	assert.Equal(t, 6.0, fn(123))
+	assert.Equal(t, 2.0, fn(0))
+}
+
+func TestComposerStart(t *testing.T) {
+	assert.Equal(t, "a", functional.Start[string]().Build()("a"))
+}
+
+func TestComposerThenPanicsOnNilFunction(t *testing.T) {
+	assert.Panics(t, func() { functional.Then[int, int, int](functional.Start[int](), nil) })
+}
+
+func TestCount(t *testing.T) {
+	iter := Iterator(-1, 0, 1)
+	assert.Equal(t, 3, functional.Count(iter))
+	assert.Equal(t, 0, functional.Count(iter))
+}
+
+func TestCountForcesEvaluation(t *testing.T) {
+	calls := 0
+	mapped := functional.Map(Iterator(1, 2, 3), func(x int) int {
+		calls++
+		return x
+	})
+
+	assert.Equal(t, 3, functional.Count(mapped))
+	assert.Equal(t, 3, calls)
+}
+
+func TestCountNilIterator(t *testing.T) {
+	assert.Equal(t, 0, functional.Count[int](nil))
+}
+
+func TestCount64(t *testing.T) {
+	iter := Iterator(-1, 0, 1)
+	assert.Equal(t, int64(3), functional.Count64(iter))
+	assert.Equal(t, int64(0), functional.Count64(iter))
+}
+
+func TestCount64NilIterator(t *testing.T) {
+	assert.Equal(t, int64(0), functional.Count64[int](nil))
+}
+
+func TestCountBy(t *testing.T) {
+	iter := Iterator(-1, 0, 1, 2)
+	assert.Equal(t, 2, functional.CountBy(iter, GreaterThan0))
+}
+
+func TestCountDistinct(t *testing.T) {
+	assert.Equal(t, 3, functional.CountDistinct(Iterator(1, 2, 1, 3, 2)))
+	assert.Equal(t, 0, functional.CountDistinct(Iterator[int]()))
+}
+
+func TestCycle(t *testing.T) {
+	iter := functional.Cycle[int](&iterator.Slice[int]{Values: []int{1, 2, 3}})
+
+	AssertIteratorEqual(t, []int{1, 2, 3, 1, 2, 3, 1}, iter)
+}
+
+func TestCycleEmpty(t *testing.T) {
+	iter := functional.Cycle[int](&iterator.Slice[int]{})
+
+	assert.False(t, iter.Next().IsSome())
+	assert.False(t, iter.Next().IsSome())
+}
+
+func TestDedup(t *testing.T) {
+	iter := functional.Dedup(Iterator(1, 1, 2, 3, 3, 3, 1))
+
+	assert.Equal(t, []int{1, 2, 3, 1}, functional.Collect(iter))
+}
+
+func TestDedupBy(t *testing.T) {
+	iter := functional.DedupBy(Iterator(1, -1, 2, -2, -3, 3), func(x int) bool { return x > 0 })
+
+	assert.Equal(t, []int{1, -1, 2, -2, 3}, functional.Collect(iter))
+}
+
+func TestDistinctLast(t *testing.T) {
+	iter := Iterator("a", "b", "a", "c", "b", "d")
+	distinct := functional.DistinctLast(iter)
+
+	assert.Equal(t, []string{"a", "c", "b", "d"}, functional.Collect(distinct))
+	assert.False(t, iter.Next().IsSome())
+}
+
+func TestDistinctLastNoValues(t *testing.T) {
+	assert.Empty(t, functional.Collect(functional.DistinctLast(Iterator[int]())))
+}
+
+func TestDrain(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{1, 2, 3}}
+	count, err := functional.Drain[int](iter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, 0, iter.Count())
+}
+
+func TestDrainForcesEvaluation(t *testing.T) {
+	calls := 0
+	mapped := functional.Map(Iterator(1, 2), func(x int) int {
+		calls++
+		return x
+	})
+
+	count, err := functional.Drain(mapped)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, 2, calls)
+}
+
+func TestDrainCloses(t *testing.T) {
+	var Error error = errors.New("error")
+	iter := &closingIterator{Slice: iterator.Slice[int]{Values: []int{1, 2}}, err: Error}
+	count, err := functional.Drain[int](iter)
+
+	assert.ErrorIs(t, err, Error)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, 0, iter.Count())
+	assert.True(t, iter.closed)
+}
+
+func TestDrainNilIterator(t *testing.T) {
+	count, err := functional.Drain[int](nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
 func TestEqualDifferentLength(t *testing.T) {
 	a := &iterator.Slice[int]{Values: []int{1}}
 	b := &iterator.Slice[int]{Values: []int{1, 2}}
@@ -91,6 +572,22 @@ func TestEqualDifferentValues(t *testing.T) {
 	assert.False(t, functional.Equal[int](a, b))
 }
 
+func TestEqualStopsOnFirstMismatch(t *testing.T) {
+	a := &iterator.Slice[int]{Values: []int{1, 2, 3}}
+	b := &iterator.Slice[int]{Values: []int{0, 2, 3}}
+
+	assert.False(t, functional.Equal[int](a, b))
+	assert.Equal(t, 2, a.Count())
+	assert.Equal(t, 2, b.Count())
+}
+
+func TestEqualMixedIterators(t *testing.T) {
+	a := &iterator.Slice[int]{Values: []int{1, 2}}
+	b := iterator.Chan[int](iterator.SendTo(1, 2))
+
+	assert.True(t, functional.Equal[int](a, b))
+}
+
 func TestEqual(t *testing.T) {
 	a := &iterator.Slice[int]{Values: []int{2, 1}}
 	b := &iterator.Slice[int]{Values: []int{2, 1}}
@@ -98,6 +595,33 @@ func TestEqual(t *testing.T) {
 	assert.True(t, functional.Equal[int](a, b))
 }
 
+func TestEqualBy(t *testing.T) {
+	a := Iterator([]int{1}, []int{2, 3})
+	b := Iterator([]int{1}, []int{2, 3})
+
+	assert.True(t, functional.EqualBy(a, b, SlicesEqual[int]))
+}
+
+func TestEqualByDifferentValues(t *testing.T) {
+	a := Iterator([]int{1}, []int{2, 3}, []int{4})
+	b := &iterator.Slice[[]int]{Values: [][]int{{1}, {3, 2}, {4}}}
+
+	assert.False(t, functional.EqualBy[[]int](a, b, SlicesEqual[int]))
+	assert.Equal(t, 1, b.Count())
+}
+
+func TestEqualByDifferentLength(t *testing.T) {
+	aChan, bChan := iterator.SendTo([]int{1}), iterator.SendTo([]int{1}, []int{2})
+	a := iterator.Chan[[]int](aChan)
+	b := iterator.Chan[[]int](bChan)
+
+	assert.False(t, functional.EqualBy[[]int](a, b, SlicesEqual[int]))
+}
+
+func TestEqualByNilIterators(t *testing.T) {
+	assert.True(t, functional.EqualBy(nil, Iterator[[]int](), SlicesEqual[int]))
+}
+
 func TestFilter(t *testing.T) {
 	ints := []int{-1, 0, 1}
 	iter := &iterator.Slice[int]{Values: ints}
@@ -106,6 +630,67 @@ func TestFilter(t *testing.T) {
 	AssertIteratorEqual(t, []int{1}, filtered)
 }
 
+func TestFilterIsLazy(t *testing.T) {
+	calls := 0
+	filtered := functional.Filter(Iterator(-1, 0, 1), func(x int) bool {
+		calls++
+		return GreaterThan0(x)
+	})
+
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, 1, filtered.Next().Expect())
+	assert.Equal(t, 3, calls)
+}
+
+func TestFilterIsNotEnumerable(t *testing.T) {
+	filtered := functional.Filter(Iterator(1, -1, 2), GreaterThan0)
+	_, ok := filtered.(iterator.Enumerable[int])
+
+	assert.False(t, ok)
+	assert.Equal(t, []int{1, 2}, functional.Collect(filtered))
+}
+
+func TestFilterEqual(t *testing.T) {
+	filtered := functional.Filter(Iterator(1, -1), GreaterThan0)
+
+	assert.True(t, functional.Equal(filtered, Iterator(1)))
+}
+
+func TestFilterMap(t *testing.T) {
+	iter := Iterator("1", "a", "2", "b")
+	mapped := functional.FilterMap(iter, Atoi)
+
+	assert.Equal(t, []int{1, 2}, functional.Collect(mapped))
+}
+
+func TestFixedPoint(t *testing.T) {
+	// Collatz-style step that settles on 1
+	step := func(x int) int {
+		if x <= 1 {
+			return 1
+		} else if x%2 == 0 {
+			return x / 2
+		}
+
+		return 3*x + 1
+	}
+
+	assert.Equal(t, 1, functional.FixedPoint(6, step, 100).Expect())
+}
+
+func TestFixedPointExceedsMaxIterations(t *testing.T) {
+	increment := func(x int) int { return x + 1 }
+
+	assert.ErrorIs(t, functional.FixedPoint(0, increment, 10).Err(), functional.ErrNoFixedPoint)
+}
+
+func TestFixedPointBy(t *testing.T) {
+	sqrt2 := func(x float64) float64 { return (x + 2/x) / 2 }
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-12 }
+
+	assert.InDelta(t, math.Sqrt2, functional.FixedPointBy(1, sqrt2, near, 100).Expect(), 1e-12)
+}
+
 func TestForEach(t *testing.T) {
 	ints := []int{-1, 0, 1}
 	iter := &iterator.Slice[int]{Values: ints}
@@ -138,6 +723,99 @@ func TestForEachCanBreak(t *testing.T) {
 	assert.Subset(t, ints, loopedValues)
 }
 
+func TestForEachErr(t *testing.T) {
+	var visited []int
+	err := functional.ForEachErr(Iterator(1, 2, 3), func(x int) error {
+		visited = append(visited, x)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, visited)
+}
+
+func TestForEachErrStopsOnError(t *testing.T) {
+	var Error error = errors.New("negative value")
+	iter := &iterator.Slice[int]{Values: []int{1, -2, 3}}
+	err := functional.ForEachErr[int](iter, func(x int) error {
+		if x < 0 {
+			return Error
+		}
+
+		return nil
+	})
+
+	assert.ErrorIs(t, err, Error)
+	assert.Equal(t, 1, iter.Count())
+}
+
+func TestForEachErrNilIterator(t *testing.T) {
+	assert.NoError(t, functional.ForEachErr[int](nil, func(int) error { return errors.New("called") }))
+}
+
+func TestGroupConsecutive(t *testing.T) {
+	iter := Iterator("apple", "avocado", "banana", "blueberry", "cherry", "apricot")
+	groups := functional.GroupConsecutive(iter, func(s string) byte { return s[0] })
+
+	assert.Equal(t, []optional.Pair[byte, []string]{
+		{First: 'a', Second: []string{"apple", "avocado"}},
+		{First: 'b', Second: []string{"banana", "blueberry"}},
+		{First: 'c', Second: []string{"cherry"}},
+		{First: 'a', Second: []string{"apricot"}},
+	}, functional.Collect(groups))
+}
+
+func TestGroupConsecutiveNoValues(t *testing.T) {
+	groups := functional.GroupConsecutive(Iterator[int](), func(x int) int { return x })
+
+	assert.False(t, groups.Next().IsSome())
+}
+
+func TestIntersperse(t *testing.T) {
+	iter := functional.Intersperse(Iterator("a", "b", "c"), ",")
+
+	assert.Equal(t, []string{"a", ",", "b", ",", "c"}, functional.Collect(iter))
+}
+
+func TestIntersperseSingleValue(t *testing.T) {
+	iter := functional.Intersperse(Iterator("a"), ",")
+
+	assert.Equal(t, []string{"a"}, functional.Collect(iter))
+}
+
+func TestIntersperseNoValues(t *testing.T) {
+	iter := functional.Intersperse(Iterator[string](), ",")
+
+	assert.Empty(t, functional.Collect(iter))
+}
+
+func TestJoin(t *testing.T) {
+	assert.Equal(t, "a, b, c", functional.Join(Iterator("a", "b", "c"), ", "))
+}
+
+func TestJoinNoValues(t *testing.T) {
+	assert.Equal(t, "", functional.Join(Iterator[string](), ", "))
+}
+
+func TestLastN(t *testing.T) {
+	iter := Iterator(1, 2, 3, 4, 5, 6, 7)
+
+	assert.Equal(t, []int{5, 6, 7}, functional.LastN(iter, 3))
+	assert.False(t, iter.Next().IsSome())
+}
+
+func TestLastNFewerValues(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, functional.LastN(Iterator(1, 2), 3))
+}
+
+func TestLastNZero(t *testing.T) {
+	assert.Empty(t, functional.LastN(Iterator(1, 2), 0))
+}
+
+func TestLastNPanicsOnNegativeCount(t *testing.T) {
+	assert.Panics(t, func() { functional.LastN(Iterator(1), -1) })
+}
+
 func TestMap(t *testing.T) {
 	ints := []int{0, 1, 2}
 	iter := &iterator.Slice[int]{Values: ints}
@@ -158,6 +836,141 @@ func TestMapToDifferentType(t *testing.T) {
 	AssertIteratorEqual(t, expected, mapped)
 }
 
+func TestMapIsLazy(t *testing.T) {
+	calls := 0
+	mapped := functional.Map(Iterator(1, 2, 3), func(x int) int {
+		calls++
+		return x
+	})
+
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, 1, mapped.Next().Expect())
+	assert.Equal(t, 1, calls)
+}
+
+func TestMapStreamsChan(t *testing.T) {
+	ch, ack := make(chan int), make(chan struct{})
+	go func() {
+		defer close(ch)
+		for _, x := range []int{1, 2, 3} {
+			ch <- x
+			<-ack
+		}
+	}()
+
+	mapped := functional.Map[int](iterator.Chan[int](ch), strconv.Itoa)
+	for _, expected := range []string{"1", "2", "3"} {
+		// The next value is not sent until the previous one is
+		// acknowledged, so Map must not wait for the channel.
+		assert.Equal(t, expected, mapped.Next().Expect())
+		ack <- struct{}{}
+	}
+
+	assert.False(t, mapped.Next().IsSome())
+}
+
+func TestMapCount(t *testing.T) {
+	mapped := functional.Map(Iterator(1, 2, 3), strconv.Itoa)
+	sized, ok := mapped.(iterator.Enumerable[string])
+
+	assert.True(t, ok)
+	assert.Equal(t, 3, sized.Count())
+	_ = mapped.Next()
+	assert.Equal(t, 2, sized.Count())
+}
+
+func TestMapCountWithoutEnumerable(t *testing.T) {
+	mapped := functional.Map[int](iterator.Chan[int](iterator.SendTo(1)), strconv.Itoa)
+	_, ok := mapped.(iterator.Enumerable[string])
+
+	assert.False(t, ok)
+}
+
+func TestMapWhile(t *testing.T) {
+	iter := &iterator.Slice[string]{Values: []string{"1", "2", "a", "3"}}
+	mapped := functional.MapWhile[string](iter, Atoi)
+
+	assert.Equal(t, []int{1, 2}, functional.Collect(mapped))
+	assert.False(t, mapped.Next().IsSome())
+	assert.Equal(t, 1, iter.Count())
+}
+
+func TestMergeSorted(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	merged := functional.MergeSorted(less, Iterator(1, 4, 7), Iterator[int](), Iterator(2, 3, 8), Iterator(0, 9))
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 7, 8, 9}, functional.Collect(merged))
+}
+
+func TestMergeSortedIsStable(t *testing.T) {
+	type Entry struct{ Key, Shard int }
+	less := func(a, b Entry) bool { return a.Key < b.Key }
+	merged := functional.MergeSorted(
+		less,
+		Iterator(Entry{1, 0}, Entry{2, 0}),
+		Iterator(Entry{1, 1}, Entry{2, 1}),
+	)
+
+	assert.Equal(t, []Entry{{1, 0}, {1, 1}, {2, 0}, {2, 1}}, functional.Collect(merged))
+}
+
+func TestMergeSortedNoIterators(t *testing.T) {
+	merged := functional.MergeSorted(func(a, b int) bool { return a < b })
+
+	assert.False(t, merged.Next().IsSome())
+}
+
+func TestNth(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{1, 2, 3, 4}}
+
+	assert.Equal(t, 3, functional.Nth[int](iter, 2).Expect())
+	assert.Equal(t, 1, iter.Count())
+}
+
+func TestNthBeyondLength(t *testing.T) {
+	assert.False(t, functional.Nth(Iterator(1, 2), 2).IsSome())
+}
+
+func TestNthPanicsOnNegativeIndex(t *testing.T) {
+	assert.Panics(t, func() { functional.Nth(Iterator(1), -1) })
+}
+
+func TestPartitionIter(t *testing.T) {
+	iter := Iterator(-2, 1, -1, 2, 0, 3)
+	matched, unmatched := functional.PartitionIter(iter, GreaterThan0)
+
+	assert.Equal(t, []int{1, 2, 3}, functional.Collect(matched))
+	assert.Equal(t, []int{-2, -1, 0}, functional.Collect(unmatched))
+}
+
+func TestPartitionIterInterleaved(t *testing.T) {
+	iter := Iterator(-2, 1, -1, 2)
+	matched, unmatched := functional.PartitionIter(iter, GreaterThan0)
+
+	assert.Equal(t, 1, matched.Next().Expect())
+	assert.Equal(t, -2, unmatched.Next().Expect())
+	assert.Equal(t, -1, unmatched.Next().Expect())
+	assert.Equal(t, 2, matched.Next().Expect())
+	assert.False(t, matched.Next().IsSome())
+	assert.False(t, unmatched.Next().IsSome())
+}
+
+func TestPartitionOptions(t *testing.T) {
+	iter := Iterator(optional.Some(1), optional.None[int](), optional.Some(2), optional.None[int]())
+	values, noneCount := functional.PartitionOptions(iter)
+
+	assert.Equal(t, []int{1, 2}, values)
+	assert.Equal(t, 2, noneCount)
+}
+
+func TestPartitionOptionsNoValues(t *testing.T) {
+	values, noneCount := functional.PartitionOptions(Iterator[optional.Option[int]]())
+
+	assert.NotNil(t, values)
+	assert.Empty(t, values)
+	assert.Zero(t, noneCount)
+}
+
 func TestReduce(t *testing.T) {
 	ints := []int{0, 1, 2}
 	iter := &iterator.Slice[int]{Values: ints}
@@ -184,6 +997,159 @@ func TestReduceToDifferentType(t *testing.T) {
 	assert.Equal(t, expected, reduced)
 }
 
+func TestReduce1(t *testing.T) {
+	max := func(a, b int) int {
+		if a > b {
+			return a
+		}
+
+		return b
+	}
+
+	assert.Equal(t, -1, functional.Reduce1(Iterator(-3, -1, -2), max).Expect())
+}
+
+func TestReduce1NoValues(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+
+	assert.False(t, functional.Reduce1(Iterator[int](), sum).IsSome())
+}
+
+func TestReduceContext(t *testing.T) {
+	sum, err := functional.ReduceContext(context.Background(), Iterator(1, 2, 3), 10, func(acc, x int) int { return acc + x })
+
+	assert.NoError(t, err)
+	assert.Equal(t, 16, sum)
+}
+
+func TestReduceContextStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int)
+	go func() {
+		ch <- 1
+		ch <- 2
+		cancel()
+	}()
+
+	sum, err := functional.ReduceContext[int](ctx, iterator.Chan[int](ch), 0, func(acc, x int) int { return acc + x })
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 3, sum)
+}
+
+func TestReduceContextDoesNotLoseValuesOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	source := &iterator.Slice[int]{Values: []int{1, 2, 3}}
+	iter := iterator.Func[int](func() optional.Option[int] {
+		opt := source.Next()
+		if opt.Get() == 2 {
+			cancel()
+		}
+
+		return opt
+	})
+
+	sum, err := functional.ReduceContext[int](ctx, iter, 0, func(acc, x int) int { return acc + x })
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 3, sum)
+	assert.Equal(t, 3, iter.Next().Expect())
+}
+
+func TestReduceInPlace(t *testing.T) {
+	type Index struct {
+		ByLength map[int][]string
+		Total    int
+	}
+
+	index := Index{ByLength: map[int][]string{}}
+	functional.ReduceInPlace(Iterator("a", "bb", "c"), &index, func(acc *Index, s string) {
+		acc.ByLength[len(s)] = append(acc.ByLength[len(s)], s)
+		acc.Total++
+	})
+
+	assert.Equal(t, Index{ByLength: map[int][]string{1: {"a", "c"}, 2: {"bb"}}, Total: 3}, index)
+}
+
+func TestReduceInPlacePanicsOnNilAccumulator(t *testing.T) {
+	assert.Panics(t, func() { functional.ReduceInPlace[int, int](Iterator(1), nil, func(*int, int) {}) })
+}
+
+func TestReduceWindows(t *testing.T) {
+	sum := func(window []int) int { return functional.Sum[int](Iterator(window...)) }
+	reduced := functional.ReduceWindows(Iterator(1, 2, 3, 4, 5, 6, 7), 3, sum)
+
+	assert.Equal(t, []int{6, 15, 7}, functional.Collect(reduced))
+}
+
+func TestReduceWindowsPanicsOnNonPositiveSize(t *testing.T) {
+	assert.Panics(t, func() {
+		functional.ReduceWindows(Iterator(1), 0, func(window []int) int { return 0 })
+	})
+}
+
+func TestResultChain(t *testing.T) {
+	var ErrNegative error = errors.New("negative value")
+	calls := 0
+	decrement := func(x int) optional.Result[int] {
+		calls++
+		if x <= 0 {
+			return optional.Err[int](ErrNegative)
+		}
+
+		return optional.Ok(x - 1)
+	}
+
+	double := func(x int) optional.Result[int] { return optional.Ok(x * 2) }
+
+	assert.Equal(t, 2, functional.ResultChain(decrement, double)(2).Expect())
+	assert.Equal(t, 1, calls)
+
+	assert.ErrorIs(t, functional.ResultChain(decrement, decrement, double)(1).Err(), ErrNegative)
+	assert.Equal(t, 3, calls)
+}
+
+func TestResultChainNoFunctions(t *testing.T) {
+	assert.Equal(t, 42, functional.ResultChain[int]()(42).Expect())
+}
+
+func TestResultChainPanicsOnNilFunction(t *testing.T) {
+	assert.Panics(t, func() { functional.ResultChain[int](nil) })
+}
+
+func TestSlidingWindowOverlapping(t *testing.T) {
+	windows := functional.SlidingWindow(Iterator(1, 2, 3, 4, 5), 3, 1)
+
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, functional.Collect(windows))
+}
+
+func TestSlidingWindowChunks(t *testing.T) {
+	windows := functional.SlidingWindow(Iterator(1, 2, 3, 4, 5), 2, 2)
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, functional.Collect(windows))
+}
+
+func TestSlidingWindowStrided(t *testing.T) {
+	windows := functional.SlidingWindow(Iterator(1, 2, 3, 4, 5, 6, 7, 8), 2, 3)
+
+	assert.Equal(t, [][]int{{1, 2}, {4, 5}, {7, 8}}, functional.Collect(windows))
+}
+
+func TestSlidingWindowPartialOverlap(t *testing.T) {
+	windows := functional.SlidingWindow(Iterator(1, 2, 3, 4, 5, 6), 4, 3)
+
+	assert.Equal(t, [][]int{{1, 2, 3, 4}, {4, 5, 6}}, functional.Collect(windows))
+}
+
+func TestSlidingWindowNoValues(t *testing.T) {
+	assert.Empty(t, functional.Collect(functional.SlidingWindow(Iterator[int](), 2, 1)))
+}
+
+func TestSlidingWindowPanicsOnNonPositiveArguments(t *testing.T) {
+	assert.Panics(t, func() { functional.SlidingWindow(Iterator(1), 0, 1) })
+	assert.Panics(t, func() { functional.SlidingWindow(Iterator(1), 1, 0) })
+}
+
 func TestSort(t *testing.T) {
 	testSort := func(stable bool) func(t *testing.T) {
 		return func(t *testing.T) {
@@ -214,6 +1180,227 @@ func TestSortStable(t *testing.T) {
 
 }
 
+func TestSortStableBy(t *testing.T) {
+	words := Iterator("bb", "a", "cc", "b", "aa")
+	sorted := functional.SortStableBy(words, func(a, b string) bool { return len(a) < len(b) })
+
+	assert.Equal(t, []string{"a", "b", "bb", "cc", "aa"}, functional.Collect(sorted))
+}
+
+func TestSplitAt(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{1, 2, 3, 4}}
+	head, rest := functional.SplitAt[int](iter, 1)
+
+	assert.Equal(t, []int{1}, head)
+	assert.Equal(t, 3, iter.Count())
+	assert.Equal(t, []int{2, 3, 4}, functional.Collect(rest))
+}
+
+func TestSplitAtBeyondLength(t *testing.T) {
+	head, rest := functional.SplitAt(Iterator(1, 2), 5)
+
+	assert.Equal(t, []int{1, 2}, head)
+	assert.False(t, rest.Next().IsSome())
+}
+
+func TestSplitAtLargeCount(t *testing.T) {
+	head, _ := functional.SplitAt(Iterator(1, 2), math.MaxInt)
+
+	assert.Equal(t, []int{1, 2}, head)
+}
+
+func TestSplitAtPanicsOnNegativeCount(t *testing.T) {
+	assert.Panics(t, func() { functional.SplitAt(Iterator(1), -1) })
+}
+
+func TestSplitBy(t *testing.T) {
+	isSpace := func(r rune) bool { return r == ' ' }
+	iter := functional.SplitBy(Iterator([]rune("  ab c   de ")...), isSpace)
+
+	assert.Equal(t, [][]rune{[]rune("ab"), []rune("c"), []rune("de")}, functional.Collect(iter))
+}
+
+func TestSplitByNoDelimiters(t *testing.T) {
+	iter := functional.SplitBy(Iterator(1, 2, 3), func(x int) bool { return x == 0 })
+
+	assert.Equal(t, [][]int{{1, 2, 3}}, functional.Collect(iter))
+}
+
+func TestSplitByOnlyDelimiters(t *testing.T) {
+	iter := functional.SplitBy(Iterator(0, 0), func(x int) bool { return x == 0 })
+
+	assert.False(t, iter.Next().IsSome())
+}
+
+func TestStartsWith(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{1, 2, 3}}
+
+	assert.True(t, functional.StartsWith[int](iter, Iterator(1, 2)))
+	assert.Equal(t, 1, iter.Count())
+}
+
+func TestStartsWithMismatch(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{1, 2, 3}}
+
+	assert.False(t, functional.StartsWith[int](iter, Iterator(2, 3)))
+	assert.Equal(t, 2, iter.Count())
+}
+
+func TestStartsWithLongerPrefix(t *testing.T) {
+	assert.False(t, functional.StartsWith(Iterator(1), Iterator(1, 2)))
+}
+
+func TestStartsWithEmptyPrefix(t *testing.T) {
+	assert.True(t, functional.StartsWith(Iterator(1), Iterator[int]()))
+}
+
+func TestTimeout(t *testing.T) {
+	result := functional.Timeout(time.Second, func() int { return 42 })
+
+	assert.Equal(t, 42, result.Expect())
+}
+
+func TestTimeoutExceeded(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	result := functional.Timeout(time.Millisecond, func() int {
+		<-done
+		return 42
+	})
+
+	assert.False(t, result.IsSome())
+}
+
+func TestTryReduce(t *testing.T) {
+	sum := func(accum int, cur int) optional.Result[int] { return optional.Ok(accum + cur) }
+
+	assert.Equal(t, 16, functional.TryReduce(Iterator(1, 2, 3), 10, sum).Expect())
+}
+
+func TestTryReduceStopsOnError(t *testing.T) {
+	var Error error = errors.New("negative value")
+	iter := &iterator.Slice[int]{Values: []int{1, -2, 3}}
+	sum := func(accum int, cur int) optional.Result[int] {
+		if cur < 0 {
+			return optional.Err[int](Error)
+		}
+
+		return optional.Ok(accum + cur)
+	}
+
+	assert.ErrorIs(t, functional.TryReduce[int](iter, 0, sum).Err(), Error)
+	assert.Equal(t, 1, iter.Count())
+}
+
+func TestValidateChunks(t *testing.T) {
+	var sizes []int
+	validate := func(chunk []int) error {
+		sizes = append(sizes, len(chunk))
+		return nil
+	}
+
+	assert.Equal(t, 5, functional.ValidateChunks(Iterator(1, 2, 3, 4, 5), 2, validate).Expect())
+	assert.Equal(t, []int{2, 2, 1}, sizes)
+}
+
+func TestValidateChunksStopsOnError(t *testing.T) {
+	var Error error = errors.New("unsorted chunk")
+	iter := &iterator.Slice[int]{Values: []int{1, 2, 4, 3, 5, 6}}
+	validate := func(chunk []int) error {
+		if !sort.IntsAreSorted(chunk) {
+			return Error
+		}
+
+		return nil
+	}
+
+	assert.ErrorIs(t, functional.ValidateChunks[int](iter, 2, validate).Err(), Error)
+	assert.Equal(t, 2, iter.Count())
+}
+
+func TestValidateChunksPanicsOnNonPositiveSize(t *testing.T) {
+	assert.Panics(t, func() { functional.ValidateChunks(Iterator(1), 0, func([]int) error { return nil }) })
+}
+
+func TestWithDefault(t *testing.T) {
+	iter := functional.WithDefault(Iterator(1, 2), -1)
+
+	AssertIteratorEqual(t, []int{1, 2}, iter)
+	assert.False(t, iter.Next().IsSome())
+}
+
+func TestWithDefaultNoValues(t *testing.T) {
+	iter := functional.WithDefault(Iterator[string](), "none")
+
+	AssertIteratorEqual(t, []string{"none"}, iter)
+	assert.False(t, iter.Next().IsSome())
+}
+
+func TestWithDefaultIsLazy(t *testing.T) {
+	calls := 0
+	source := iterator.Func[int](func() optional.Option[int] {
+		calls++
+		return optional.None[int]()
+	})
+
+	iter := functional.WithDefault[int](source, 0)
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, 0, iter.Next().Expect())
+	assert.Equal(t, 1, calls)
+}
+
+func TestZip(t *testing.T) {
+	zipped := functional.Zip(Iterator(1, 2, 3), Iterator("a", "b"))
+
+	assert.Equal(t, []optional.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}, functional.Collect(zipped))
+}
+
+func TestZip3(t *testing.T) {
+	zipped := functional.Zip3(Iterator(1, 2), Iterator("a", "b", "c"), Iterator(true, false))
+
+	assert.Equal(t, []optional.Triple[int, string, bool]{
+		{First: 1, Second: "a", Third: true},
+		{First: 2, Second: "b", Third: false},
+	}, functional.Collect(zipped))
+}
+
+func TestZipSlices(t *testing.T) {
+	zipped := functional.ZipSlices(Iterator(1, 2, 3), Iterator(4, 5), Iterator(6, 7, 8))
+
+	assert.Equal(t, [][]int{{1, 4, 6}, {2, 5, 7}}, functional.Collect(zipped))
+}
+
+func TestZipSlicesNoIterators(t *testing.T) {
+	assert.False(t, functional.ZipSlices[int]().Next().IsSome())
+}
+
+func TestZipWith(t *testing.T) {
+	sums := functional.ZipWith(Iterator(1, 2, 3), Iterator(10, 20), func(a, b int) int { return a + b })
+
+	AssertIteratorEqual(t, []int{11, 22}, sums)
+	assert.False(t, sums.Next().IsSome())
+}
+
+func TestZipWithIsLazy(t *testing.T) {
+	calls := 0
+	zipped := functional.ZipWith(Iterator(1), Iterator("a"), func(x int, s string) string {
+		calls++
+		return strconv.Itoa(x) + s
+	})
+
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, "1a", zipped.Next().Expect())
+	assert.Equal(t, 1, calls)
+}
+
+func Atoi(s string) optional.Option[int] {
+	if x, err := strconv.Atoi(s); err == nil {
+		return optional.Some(x)
+	}
+
+	return optional.None[int]()
+}
+
 func AssertIteratorEqual[T comparable](t *testing.T, expected []T, iter iterator.Iterator[T]) bool {
 	for idx, v := range expected {
 		if v != iter.Next().Expect() {
@@ -238,6 +1425,20 @@ func Iterator[T any](values ...T) iterator.Iterator[T] {
 	return &iterator.Slice[T]{Values: values}
 }
 
+func SlicesEqual[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+
+	return true
+}
+
 func SortCopy[T functional.Comparable](arr []T, stable bool) []T {
 	cpy := append(make([]T, 0, len(arr)), arr...)
 	less := func(i, j int) bool { return cpy[i].Less(cpy[j]) }
@@ -256,3 +1457,8 @@ func SortCopy[T functional.Comparable](arr []T, stable bool) []T {
 func (me Int) Less(other functional.Comparable) bool {
 	return me < other.(Int)
 }
+
+func (c *closingIterator) Close() error {
+	c.closed = true
+	return c.err
+}
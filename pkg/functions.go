@@ -0,0 +1,372 @@
+package functional
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/standoffvenus/functional/v2/pkg/iterator"
+	"github.com/standoffvenus/functional/v2/pkg/optional"
+)
+
+// Apply will pass the provided slice through each of the
+// provided functions from left to right, i.e.
+//  Apply(list, f, g, h) // == h(g(f(list)))
+// If any function is nil, Apply will panic.
+func Apply[T any](list []T, fns ...func([]T) []T) []T {
+	for idx, fn := range fns {
+		if fn == nil {
+			bork("nil function at index %d of apply", idx)
+		}
+	}
+
+	for _, fn := range fns {
+		list = fn(list)
+	}
+
+	return list
+}
+
+// Each will invoke fn with each element of the provided
+// slice, in order. Unlike ForEach, there is no way to
+// break early; Each is intended for side effects such as
+// logging.
+func Each[T any](list []T, fn func(T)) {
+	for _, x := range list {
+		fn(x)
+	}
+}
+
+// EachIndexed is the same as Each, except fn is also passed
+// the index of each element.
+func EachIndexed[T any](list []T, fn func(int, T)) {
+	for idx, x := range list {
+		fn(idx, x)
+	}
+}
+
+// EndsWith will return whether the provided slice ends
+// with the values of suffix. An empty suffix always
+// matches.
+func EndsWith[T comparable](list, suffix []T) bool {
+	if len(suffix) > len(list) {
+		return false
+	}
+
+	offset := len(list) - len(suffix)
+	for idx, v := range suffix {
+		if list[offset+idx] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Fill will overwrite every element of dst with value.
+func Fill[T any](dst []T, value T) {
+	for idx := range dst {
+		dst[idx] = value
+	}
+}
+
+// FilterMapSlice is the same as FilterMap, except it operates
+// on a slice. The values of the Some options returned from fn
+// are kept in order. The returned slice is not nil.
+func FilterMapSlice[From, To any](list []From, fn func(From) optional.Option[To]) []To {
+	values := make([]To, 0, len(list))
+	for _, x := range list {
+		if opt := fn(x); opt.IsSome() {
+			values = append(values, opt.Expect())
+		}
+	}
+
+	return values
+}
+
+// ForEachParallel will invoke fn with each element of the
+// provided slice across the given number of Goroutines,
+// blocking until every invocation returns. If workers is
+// not positive, runtime.NumCPU() workers are used. The order
+// in which elements are processed is unspecified.
+func ForEachParallel[T any](list []T, workers int, fn func(T)) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	values := make(chan T, len(list))
+	for _, v := range list {
+		values <- v
+	}
+	close(values)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(list); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range values {
+				fn(v)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// IntRange will return a slice of the integers from start
+// (inclusive) to stop (exclusive), incrementing by step.
+// If step is negative, the range descends from start. If
+// the range is empty, an empty non-nil slice is returned.
+// If step is 0, IntRange will panic.
+func IntRange(start, stop, step int) []int {
+	if step == 0 {
+		bork("zero step passed to int range")
+	}
+
+	count := 0
+	if step > 0 && start < stop {
+		count = (stop - start + step - 1) / step
+	} else if step < 0 && start > stop {
+		count = (start - stop - step - 1) / -step
+	}
+
+	return Times(count, func(i int) int { return start + i*step })
+}
+
+// MapChunked will split the provided slice into chunks of
+// chunkSize elements (the final chunk may be shorter) and
+// invoke mapper on each chunk across the given number of
+// Goroutines. The results are concatenated in the order of
+// their chunks. If workers is not positive, runtime.NumCPU()
+// workers are used. If chunkSize is not positive, MapChunked
+// will panic.
+//
+// Each chunk is a subslice of list with its capacity limited
+// to its length, so appending to a chunk will not overwrite
+// other chunks.
+func MapChunked[From, To any](list []From, chunkSize, workers int, mapper func([]From) []To) []To {
+	if chunkSize <= 0 {
+		bork("non-positive chunk size %d passed to map chunked", chunkSize)
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	results := make([][]To, (len(list)+chunkSize-1)/chunkSize)
+	indices := make(chan int, len(results))
+	for idx := range results {
+		indices <- idx
+	}
+	close(indices)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(results); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indices {
+				lo, hi := idx*chunkSize, (idx+1)*chunkSize
+				if hi > len(list) {
+					hi = len(list)
+				}
+
+				results[idx] = mapper(list[lo:hi:hi])
+			}
+		}()
+	}
+	wg.Wait()
+
+	size := 0
+	for _, r := range results {
+		size += len(r)
+	}
+
+	mapped := make([]To, 0, size)
+	for _, r := range results {
+		mapped = append(mapped, r...)
+	}
+
+	return mapped
+}
+
+// MapCollectErrors will invoke fn for each element of the
+// provided slice, returning the values of OK results and
+// the errors of erroneous results in separate slices. Unlike
+// CollectResults, every element is mapped regardless of
+// errors. Neither returned slice is nil.
+func MapCollectErrors[From, To any](list []From, fn func(From) optional.Result[To]) ([]To, []error) {
+	values, errs := make([]To, 0, len(list)), []error{}
+	for _, x := range list {
+		if r := fn(x); r.Ok() {
+			values = append(values, r.Expect())
+		} else {
+			errs = append(errs, r.Err())
+		}
+	}
+
+	return values, errs
+}
+
+// MapKeys will return a map containing the entries of m
+// with each key replaced by the result of invoking fn on
+// it. If fn returns the same key for distinct keys of m,
+// the last of them wins: since map iteration order is
+// unspecified, the keys are ordered by comparing their
+// fmt.Sprint representations as strings, and the value of
+// the key whose representation sorts last is kept. Which of
+// several keys with equal representations wins, such as NaN
+// keys, remains unspecified.
+func MapKeys[K, L comparable, V any](m map[K]V, fn func(K) L) map[L]V {
+	mapped := make(map[L]V, len(m))
+	sources := make(map[L]K, len(m))
+	for k, v := range m {
+		l := fn(k)
+		if source, ok := sources[l]; ok && fmt.Sprint(k) < fmt.Sprint(source) {
+			continue
+		}
+
+		mapped[l], sources[l] = v, k
+	}
+
+	return mapped
+}
+
+// MapValues will return a map containing the keys of m,
+// with each value replaced by the result of invoking fn
+// on it.
+func MapValues[K comparable, V, W any](m map[K]V, fn func(V) W) map[K]W {
+	mapped := make(map[K]W, len(m))
+	for k, v := range m {
+		mapped[k] = fn(v)
+	}
+
+	return mapped
+}
+
+// ParallelReduce will combine the elements of the provided
+// slice using op, splitting the slice into contiguous chunks
+// that are reduced in parallel across the given number of
+// Goroutines before the partial results are combined in order.
+// If workers is not positive, runtime.NumCPU() workers are used.
+//
+// op must be associative and identity must be its identity
+// element (i.e. op(identity, x) == x); otherwise, the result
+// is unspecified. op need not be commutative. If the slice is
+// empty, identity is returned.
+func ParallelReduce[T any](list []T, identity T, op func(T, T) T, workers int) T {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	chunkSize := (len(list) + workers - 1) / workers
+	if chunkSize == 0 {
+		return identity
+	}
+
+	partials := MapChunked(list, chunkSize, workers, func(chunk []T) []T {
+		accumulator := identity
+		for _, x := range chunk {
+			accumulator = op(accumulator, x)
+		}
+
+		return []T{accumulator}
+	})
+
+	accumulator := identity
+	for _, x := range partials {
+		accumulator = op(accumulator, x)
+	}
+
+	return accumulator
+}
+
+// ReduceMap will call fn with each entry of the provided
+// map, starting with initial as the accumulated value. Like
+// ranging over a map, the order of entries is unspecified.
+// If m is empty or nil, initial is returned.
+func ReduceMap[K comparable, V, Acc any](m map[K]V, initial Acc, fn func(Acc, K, V) Acc) Acc {
+	accumulator := initial
+	for k, v := range m {
+		accumulator = fn(accumulator, k, v)
+	}
+
+	return accumulator
+}
+
+// ReduceRight is the same as Reduce, except it operates
+// on a slice and invokes the provided function from the
+// last element to the first.
+//
+// The first argument passed to reducer will be the
+// current element, whereas the second argument will be
+// the "accumulated" value from previous invocations.
+func ReduceRight[From, To any](list []From, reducer func(cur From, accum To) To) To {
+	var accumulator To
+	for idx := len(list) - 1; idx >= 0; idx-- {
+		accumulator = reducer(list[idx], accumulator)
+	}
+
+	return accumulator
+}
+
+// Repeat will return a slice containing value repeated
+// count times. If count is negative, Repeat will panic.
+func Repeat[T any](value T, count int) []T {
+	if count < 0 {
+		bork("negative count %d passed to repeat", count)
+	}
+
+	values := make([]T, count)
+	Fill(values, value)
+
+	return values
+}
+
+// SafeMap will invoke fn for each element of the provided
+// slice, recovering from any panic. Values returned by fn
+// are wrapped in Ok, whereas panics are converted to errors
+// and returned as Err, as with iterator.Recover. If the
+// recovered value is an error, it will be wrapped by the
+// returned error.
+func SafeMap[From, To any](list []From, fn func(From) To) []optional.Result[To] {
+	return Collect(iterator.Recover(Map[From](&iterator.Slice[From]{Values: list}, fn)))
+}
+
+// Span will split the provided slice at the first element
+// for which pred does not hold true, returning the leading
+// elements that satisfy pred and the remaining elements.
+// Neither returned slice is nil; both share list's backing
+// array. If pred is nil, Span will panic.
+func Span[T any](list []T, pred func(T) bool) (prefix []T, rest []T) {
+	if pred == nil {
+		bork("nil predicate passed to span")
+	}
+
+	idx := 0
+	for idx < len(list) && pred(list[idx]) {
+		idx++
+	}
+
+	if list == nil {
+		list = []T{}
+	}
+
+	return list[:idx:idx], list[idx:]
+}
+
+// Times will call fn with each index from 0 to n - 1,
+// returning the results in a slice. If n is negative,
+// Times will panic.
+func Times[T any](n int, fn func(i int) T) []T {
+	if n < 0 {
+		bork("negative count %d passed to times", n)
+	}
+
+	values := make([]T, n)
+	for idx := range values {
+		values[idx] = fn(idx)
+	}
+
+	return values
+}
@@ -0,0 +1,340 @@
+package functional_test
+
+import (
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	functional "github.com/standoffvenus/functional/v2/pkg"
+	"github.com/standoffvenus/functional/v2/pkg/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApply(t *testing.T) {
+	positive := func(list []int) []int {
+		return functional.Collect(functional.Filter(Iterator(list...), GreaterThan0))
+	}
+	double := func(list []int) []int {
+		return functional.Collect(functional.Map(Iterator(list...), func(x int) int { return x * 2 }))
+	}
+
+	assert.Equal(t, []int{2, 4}, functional.Apply([]int{-1, 1, 0, 2}, positive, double))
+}
+
+func TestApplyNoFunctions(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, functional.Apply([]int{1, 2}))
+}
+
+func TestApplyPanicsOnNilFunction(t *testing.T) {
+	called := false
+	fn := func(list []int) []int {
+		called = true
+		return list
+	}
+
+	assert.Panics(t, func() { functional.Apply([]int{1}, fn, nil) })
+	assert.False(t, called)
+}
+
+func TestEach(t *testing.T) {
+	var visited []string
+	functional.Each([]string{"a", "b", "c"}, func(s string) { visited = append(visited, s) })
+
+	assert.Equal(t, []string{"a", "b", "c"}, visited)
+}
+
+func TestEachIndexed(t *testing.T) {
+	visited := map[int]string{}
+	functional.EachIndexed([]string{"a", "b"}, func(idx int, s string) { visited[idx] = s })
+
+	assert.Equal(t, map[int]string{0: "a", 1: "b"}, visited)
+}
+
+func TestEndsWith(t *testing.T) {
+	assert.True(t, functional.EndsWith([]int{1, 2, 3}, []int{2, 3}))
+	assert.True(t, functional.EndsWith([]int{1, 2, 3}, nil))
+	assert.False(t, functional.EndsWith([]int{1, 2, 3}, []int{1, 2}))
+	assert.False(t, functional.EndsWith([]int{3}, []int{2, 3}))
+}
+
+func TestFill(t *testing.T) {
+	values := []int{1, 2, 3}
+	functional.Fill(values, 42)
+
+	assert.Equal(t, []int{42, 42, 42}, values)
+}
+
+func TestFilterMapSlice(t *testing.T) {
+	parsed := functional.FilterMapSlice([]string{"1", "a", "2"}, func(s string) optional.Option[int] {
+		if x, err := strconv.Atoi(s); err == nil {
+			return optional.Some(x)
+		}
+
+		return optional.None[int]()
+	})
+
+	assert.Equal(t, []int{1, 2}, parsed)
+}
+
+func TestFilterMapSliceNonNil(t *testing.T) {
+	assert.NotNil(t, functional.FilterMapSlice(nil, func(x int) optional.Option[int] { return optional.None[int]() }))
+}
+
+func TestForEachParallel(t *testing.T) {
+	const Size = 1000
+	var total int64
+	counts := make([]int64, Size)
+
+	functional.ForEachParallel(functional.IntRange(0, Size, 1), 8, func(i int) {
+		atomic.AddInt64(&counts[i], 1)
+		atomic.AddInt64(&total, 1)
+	})
+
+	assert.Equal(t, int64(Size), atomic.LoadInt64(&total))
+	assert.Equal(t, functional.Repeat(int64(1), Size), counts)
+}
+
+func TestForEachParallelDefaultWorkers(t *testing.T) {
+	var total int64
+	functional.ForEachParallel([]int64{1, 2, 3}, 0, func(x int64) { atomic.AddInt64(&total, x) })
+
+	assert.Equal(t, int64(6), total)
+}
+
+func TestIntRange(t *testing.T) {
+	assert.Equal(t, []int{0, 1, 2, 3}, functional.IntRange(0, 4, 1))
+	assert.Equal(t, []int{1, 4, 7}, functional.IntRange(1, 8, 3))
+	assert.Equal(t, []int{1, 4, 7}, functional.IntRange(1, 10, 3))
+}
+
+func TestIntRangeDescending(t *testing.T) {
+	assert.Equal(t, []int{5, 4, 3}, functional.IntRange(5, 2, -1))
+	assert.Equal(t, []int{5, 3}, functional.IntRange(5, 2, -2))
+}
+
+func TestIntRangeEmpty(t *testing.T) {
+	for _, values := range [][]int{
+		functional.IntRange(0, 0, 1),
+		functional.IntRange(4, 0, 1),
+		functional.IntRange(0, 4, -1),
+	} {
+		assert.NotNil(t, values)
+		assert.Empty(t, values)
+	}
+}
+
+func TestIntRangePanicsOnZeroStep(t *testing.T) {
+	assert.Panics(t, func() { functional.IntRange(0, 1, 0) })
+}
+
+func TestMapChunked(t *testing.T) {
+	list := functional.IntRange(0, 103, 1)
+	double := func(chunk []int) []int {
+		doubled := make([]int, len(chunk))
+		for idx, x := range chunk {
+			doubled[idx] = x * 2
+		}
+
+		return doubled
+	}
+
+	assert.Equal(t, functional.IntRange(0, 206, 2), functional.MapChunked(list, 10, 4, double))
+}
+
+func TestMapChunkedSizes(t *testing.T) {
+	sizes := functional.MapChunked(functional.IntRange(0, 7, 1), 3, 0, func(chunk []int) []int {
+		return []int{len(chunk)}
+	})
+
+	assert.Equal(t, []int{3, 3, 1}, sizes)
+}
+
+func TestMapChunkedNoValues(t *testing.T) {
+	mapped := functional.MapChunked(nil, 3, 2, func(chunk []int) []int { return chunk })
+
+	assert.NotNil(t, mapped)
+	assert.Empty(t, mapped)
+}
+
+func TestMapChunkedPanicsOnNonPositiveChunkSize(t *testing.T) {
+	assert.Panics(t, func() {
+		functional.MapChunked([]int{1}, 0, 1, func(chunk []int) []int { return chunk })
+	})
+}
+
+func TestMapCollectErrors(t *testing.T) {
+	values, errs := functional.MapCollectErrors([]string{"1", "a", "2", "b"}, func(s string) optional.Result[int] {
+		x, err := strconv.Atoi(s)
+		if err != nil {
+			return optional.Err[int](err)
+		}
+
+		return optional.Ok(x)
+	})
+
+	assert.Equal(t, []int{1, 2}, values)
+	assert.Len(t, errs, 2)
+}
+
+func TestMapCollectErrorsNonNil(t *testing.T) {
+	values, errs := functional.MapCollectErrors(nil, func(x int) optional.Result[int] { return optional.Ok(x) })
+
+	assert.NotNil(t, values)
+	assert.NotNil(t, errs)
+}
+
+func TestMapKeys(t *testing.T) {
+	m := map[int]string{1: "a", 2: "b"}
+	mapped := functional.MapKeys(m, func(k int) int { return k * 10 })
+
+	assert.Equal(t, map[int]string{10: "a", 20: "b"}, mapped)
+}
+
+func TestMapKeysCollision(t *testing.T) {
+	m := map[int]string{1: "a", -1: "b"}
+	mapped := functional.MapKeys(m, func(k int) int { return k * k })
+
+	assert.Equal(t, map[int]string{1: "a"}, mapped)
+}
+
+func TestMapKeysCollisionOrdersByRepresentation(t *testing.T) {
+	m := map[int]string{2: "a", 10: "b", 3: "c"}
+	mapped := functional.MapKeys(m, func(int) int { return 0 })
+
+	assert.Equal(t, map[int]string{0: "c"}, mapped)
+}
+
+func TestMapValues(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	mapped := functional.MapValues(m, strconv.Itoa)
+
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, mapped)
+}
+
+func TestParallelReduce(t *testing.T) {
+	list := functional.IntRange(0, 1001, 1)
+	expected := functional.Reduce[int](Iterator(list...), func(accum, cur int) int { return accum + cur })
+
+	for _, workers := range []int{0, 1, 3, 8, 2000} {
+		assert.Equal(t, expected, functional.ParallelReduce(list, 0, func(a, b int) int { return a + b }, workers))
+	}
+}
+
+func TestParallelReducePreservesOrder(t *testing.T) {
+	list := []string{"a", "b", "c", "d", "e"}
+	concat := func(a, b string) string { return a + b }
+
+	assert.Equal(t, "abcde", functional.ParallelReduce(list, "", concat, 2))
+}
+
+func TestParallelReduceNoValues(t *testing.T) {
+	assert.Equal(t, 1, functional.ParallelReduce(nil, 1, func(a, b int) int { return a * b }, 4))
+}
+
+func TestReduceMap(t *testing.T) {
+	stock := map[string]int{"apples": 3, "pears": 4}
+	total := functional.ReduceMap(stock, 10, func(acc int, _ string, count int) int { return acc + count })
+
+	assert.Equal(t, 17, total)
+}
+
+func TestReduceMapNilMap(t *testing.T) {
+	called := false
+	result := functional.ReduceMap(map[string]int(nil), "initial", func(acc string, _ string, _ int) string {
+		called = true
+		return acc
+	})
+
+	assert.Equal(t, "initial", result)
+	assert.False(t, called)
+}
+
+func TestReduceRight(t *testing.T) {
+	reduced := functional.ReduceRight([]string{"a", "b", "c"}, func(cur string, accum string) string {
+		return accum + cur
+	})
+
+	assert.Equal(t, "cba", reduced)
+}
+
+func TestReduceRightNonAssociative(t *testing.T) {
+	// 1 - (2 - (3 - 0)) == 2
+	reduced := functional.ReduceRight([]int{1, 2, 3}, func(cur int, accum int) int { return cur - accum })
+
+	assert.Equal(t, 2, reduced)
+}
+
+func TestReduceRightNoValues(t *testing.T) {
+	reduced := functional.ReduceRight(nil, func(cur int, accum int) int { return cur + accum })
+
+	assert.Equal(t, 0, reduced)
+}
+
+func TestRepeat(t *testing.T) {
+	assert.Equal(t, []string{"a", "a", "a"}, functional.Repeat("a", 3))
+}
+
+func TestRepeatZero(t *testing.T) {
+	values := functional.Repeat("a", 0)
+
+	assert.NotNil(t, values)
+	assert.Empty(t, values)
+}
+
+func TestRepeatPanicsOnNegativeCount(t *testing.T) {
+	assert.Panics(t, func() { functional.Repeat("a", -1) })
+}
+
+func TestSafeMap(t *testing.T) {
+	var Error error = errors.New("error")
+	results := functional.SafeMap([]int{2, 0, -1}, func(x int) int {
+		if x < 0 {
+			panic(Error)
+		}
+
+		return 10 / x
+	})
+
+	assert.Len(t, results, 3)
+	assert.Equal(t, optional.Ok(5), results[0])
+	assert.Error(t, results[1].Err())
+	assert.ErrorIs(t, results[2].Err(), Error)
+}
+
+func TestSpan(t *testing.T) {
+	prefix, rest := functional.Span([]int{1, 2, -1, 3}, GreaterThan0)
+
+	assert.Equal(t, []int{1, 2}, prefix)
+	assert.Equal(t, []int{-1, 3}, rest)
+}
+
+func TestSpanNonNil(t *testing.T) {
+	prefix, rest := functional.Span(nil, GreaterThan0)
+
+	assert.NotNil(t, prefix)
+	assert.NotNil(t, rest)
+	assert.Empty(t, prefix)
+	assert.Empty(t, rest)
+}
+
+func TestSpanPanicsOnNilPredicate(t *testing.T) {
+	assert.Panics(t, func() { functional.Span([]int{1}, nil) })
+}
+
+func TestTimes(t *testing.T) {
+	squares := functional.Times(4, func(i int) int { return i * i })
+
+	assert.Equal(t, []int{0, 1, 4, 9}, squares)
+}
+
+func TestTimesZero(t *testing.T) {
+	values := functional.Times(0, func(i int) int { return i })
+
+	assert.NotNil(t, values)
+	assert.Empty(t, values)
+}
+
+func TestTimesPanicsOnNegativeCount(t *testing.T) {
+	assert.Panics(t, func() { functional.Times(-1, func(i int) int { return i }) })
+}
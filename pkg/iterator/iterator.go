@@ -2,8 +2,12 @@ package iterator
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
 
 	"github.com/standoffvenus/functional/v2/pkg/optional"
+	"golang.org/x/time/rate"
 )
 
 // Iterator represents a basic iterator on type T.
@@ -22,6 +26,15 @@ type Copyable[T any] interface {
 	Copy() Iterator[T]
 }
 
+// Closer represents an iterator backed by a resource,
+// such as a file or network connection, that should be
+// released once iteration is finished.
+type Closer interface {
+	// Close will release the resources held by the
+	// iterator. Calling Next after Close is undefined.
+	Close() error
+}
+
 // Enumerable represents an iterator with a size.
 type Enumerable[T any] interface {
 	Iterator[T]
@@ -49,14 +62,79 @@ type Slice[T any] struct {
 	Values []T
 
 	index int
+
+	// buffer is the array allocated by Append, if any. Append
+	// only writes in place to an array the iterator allocated.
+	buffer []T
+}
+
+// Cached represents an iterator that records the values
+// retrieved from its source iterator, so they may be
+// replayed without pulling the source again. Use Cache to
+// construct a Cached iterator.
+//
+// Every value retrieved from the source is held in memory
+// for the lifetime of the iterator (and its copies), so
+// memory grows with the number of values consumed.
+type Cached[T any] struct {
+	cache *cache[T]
+	index int
 }
 
+// cache holds the state shared between a Cached iterator
+// and its copies.
+type cache[T any] struct {
+	source Iterator[T]
+	values []T
+	done   bool
+}
+
+// Indexed represents an iterator that pairs each value
+// of its source iterator with an incrementing index,
+// starting at 0. Use WithIndex to construct an Indexed
+// iterator.
+type Indexed[T any] struct {
+	source Iterator[T]
+	index  int
+}
+
+// sizedIndexed is the iterator returned from WithIndex
+// when the source iterator implements Enumerable.
+type sizedIndexed[T any] struct{ *Indexed[T] }
+
+// blockingIndexed is the iterator returned from WithIndex
+// when the source iterator implements BlockingIterator.
+type blockingIndexed[T any] struct{ *Indexed[T] }
+
+// sizedBlockingIndexed is the iterator returned from WithIndex
+// when the source iterator implements both Enumerable and
+// BlockingIterator.
+type sizedBlockingIndexed[T any] struct{ blockingIndexed[T] }
+
 // Chan represents an iterator on a generic channel.
 // Closing the underlying channel signifies an
 // exhausted generator. A nil channel iterator will
 // block forever when Next is called.
 type Chan[T any] <-chan T
 
+// Selection represents an iterator on several generic
+// channels, retrieving values from whichever channel is
+// ready first. When a channel closes, it is removed from
+// the selection; once every channel is closed, the
+// iterator is exhausted. Use Select to construct a
+// Selection iterator.
+type Selection[T any] struct {
+	cases []reflect.SelectCase
+}
+
+// Throttled represents an iterator whose values are
+// retrieved no faster than a rate limiter allows. Use
+// RateLimited to construct a Throttled iterator.
+type Throttled[T any] struct {
+	source  Iterator[T]
+	limiter *rate.Limiter
+}
+
 // Func represents an iterator on a generic function
 // returning optional values. A nil function iterator
 // is equivalent to an exhausted iterator.
@@ -65,11 +143,120 @@ type Func[T any] func() optional.Option[T]
 var _ Iterator[int] = new(Slice[int])
 var _ Iterator[int] = Chan[int](nil)
 var _ Iterator[int] = Func[int](nil)
+var _ Iterator[int] = new(Cached[int])
+var _ Iterator[int] = new(Selection[int])
+var _ Iterator[int] = new(Throttled[int])
+
+var _ Copyable[int] = new(Slice[int])
+var _ Copyable[int] = new(Cached[int])
 
 var _ BlockingIterator[int] = new(Slice[int])
 var _ BlockingIterator[int] = Chan[int](nil)
+var _ BlockingIterator[int] = new(Selection[int])
+var _ BlockingIterator[int] = new(Throttled[int])
+
+var _ Iterator[optional.Pair[int, int]] = new(Indexed[int])
+
+var _ BlockingIterator[optional.Pair[int, int]] = blockingIndexed[int]{}
+var _ BlockingIterator[optional.Pair[int, int]] = sizedBlockingIndexed[int]{}
 
 var _ Enumerable[int] = new(Slice[int])
+var _ json.Marshaler = Slice[int]{}
+var _ json.Unmarshaler = new(Slice[int])
+var _ Enumerable[optional.Pair[int, int]] = sizedIndexed[int]{}
+var _ Enumerable[optional.Pair[int, int]] = sizedBlockingIndexed[int]{}
+
+// Cache will return a Cached iterator on the provided iterator.
+// Calling Reset on the returned iterator will replay all values
+// retrieved so far, and copies of the returned iterator replay
+// values from the point they were copied. Values not yet
+// retrieved by any copy are pulled from the source on demand.
+//
+// The returned iterator and its copies are not safe for
+// concurrent use.
+func Cache[T any](iter Iterator[T]) *Cached[T] {
+	return &Cached[T]{cache: &cache[T]{source: iter}}
+}
+
+// Generate will return an iterator that produces values by
+// repeatedly calling step, starting with the provided seed.
+// Each call to step returns the next value, the state to
+// pass to the following call, and whether iteration should
+// continue. Once step returns false, the value it returned
+// is discarded and the iterator is exhausted; step will not
+// be called again.
+func Generate[S, T any](seed S, step func(S) (T, S, bool)) Iterator[T] {
+	state, done := seed, false
+
+	return Func[T](func() optional.Option[T] {
+		if done {
+			return optional.None[T]()
+		}
+
+		next, nextState, ok := step(state)
+		if !ok {
+			done = true
+			return optional.None[T]()
+		}
+
+		state = nextState
+		return optional.Some(next)
+	})
+}
+
+// RateLimited will return a Throttled iterator on the provided
+// iterator, waiting on limiter before each value is retrieved.
+func RateLimited[T any](iter Iterator[T], limiter *rate.Limiter) *Throttled[T] {
+	return &Throttled[T]{source: iter, limiter: limiter}
+}
+
+// Recover will return an iterator that wraps each call to the
+// provided iterator's Next() in a deferred recover. Values
+// returned by the provided iterator are wrapped in Ok, whereas
+// panics are converted to errors and returned as Err. Iteration
+// may continue after an Err is returned.
+//
+// If the recovered value is an error, it will be wrapped by the
+// returned error, so errors.Is and errors.As may be used.
+//
+// Since iteration continues after a panic, the returned iterator
+// is only exhausted once the provided iterator returns None. If
+// the provided iterator panics on every call, the returned
+// iterator never ends, so it must not be passed to functions that
+// consume every value, such as Collect. A nil iterator is treated
+// as exhausted.
+func Recover[T any](iter Iterator[T]) Iterator[optional.Result[T]] {
+	if iter == nil {
+		return Func[optional.Result[T]](nil)
+	}
+
+	return Func[optional.Result[T]](func() (next optional.Option[optional.Result[T]]) {
+		defer func() {
+			if r := recover(); r != nil {
+				next = optional.Some(optional.Err[T](recovered(r)))
+			}
+		}()
+
+		if opt := iter.Next(); opt.IsSome() {
+			return optional.Some(optional.Ok(opt.Expect()))
+		}
+
+		return optional.None[optional.Result[T]]()
+	})
+}
+
+// Select will return a Selection iterator on the provided
+// channels. If multiple channels are ready, one is chosen
+// at random, so no channel is starved. A nil channel is
+// never ready.
+func Select[T any](chans ...<-chan T) *Selection[T] {
+	cases := make([]reflect.SelectCase, len(chans))
+	for idx, ch := range chans {
+		cases[idx] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)}
+	}
+
+	return &Selection[T]{cases: cases}
+}
 
 // Deprecated: Prefer SendTo.
 //
@@ -101,6 +288,51 @@ func SendTo[T any](values ...T) <-chan T {
 	return ch
 }
 
+// SliceRange will return a slice iterator on values[start:end].
+// If the range is invalid, i.e. not 0 <= start <= end <= len(values),
+// SliceRange will panic.
+func SliceRange[T any](values []T, start, end int) *Slice[T] {
+	if start < 0 || start > end || end > len(values) {
+		panic(fmt.Sprintf("iterator: invalid slice range [%d:%d] with length %d", start, end, len(values)))
+	}
+
+	return &Slice[T]{Values: values[:end:end], index: start}
+}
+
+// Unfold will return an iterator that lazily produces values
+// by repeatedly calling fn, starting with the provided initial
+// state. If fn returns Some, the pair's first value is produced
+// and its second value is passed to the next call of fn. Once
+// fn returns None, the iterator is exhausted.
+func Unfold[State, T any](initial State, fn func(State) optional.Option[optional.Pair[T, State]]) Iterator[T] {
+	return Generate(initial, func(s State) (T, State, bool) {
+		opt := fn(s)
+		pair := opt.Get()
+
+		return pair.First, pair.Second, opt.IsSome()
+	})
+}
+
+// WithIndex will return an Indexed iterator on the provided
+// iterator. If the provided iterator implements Enumerable
+// or BlockingIterator, so does the returned iterator.
+func WithIndex[T any](iter Iterator[T]) Iterator[optional.Pair[int, T]] {
+	indexed := &Indexed[T]{source: iter}
+	_, isSized := iter.(Enumerable[T])
+	_, isBlocking := iter.(BlockingIterator[T])
+
+	switch {
+	case isSized && isBlocking:
+		return sizedBlockingIndexed[T]{blockingIndexed[T]{indexed}}
+	case isSized:
+		return sizedIndexed[T]{indexed}
+	case isBlocking:
+		return blockingIndexed[T]{indexed}
+	}
+
+	return indexed
+}
+
 // WaitForNext is a general-purpose method that simplifies waiting
 // on Next() to return a value. If the provided context is canceled,
 // WaitForNext returns None.
@@ -145,7 +377,126 @@ func (s *Slice[T]) Next() optional.Option[T] {
 // iterator. The copied iterator will start from the point
 // in the slice that the existing iterator is currently
 // pointing to.
-func (s *Slice[T]) Copy() Iterator[T] { return &Slice[T]{Values: s.Values[s.index:]} }
+func (s *Slice[T]) Copy() Iterator[T] {
+	return &Slice[T]{Values: s.Values[s.index:len(s.Values):len(s.Values)]}
+}
+
+// Append will add the provided values to the end of the
+// iterator's slice. Since the iterator only tracks its
+// position in the slice, appended values will be returned
+// by future calls to Next(), even if the iterator had been
+// exhausted.
+//
+// Append never writes to an array the iterator did not
+// allocate: if Values was provided by the caller (or shares
+// its array with a copy), the values are copied to a new
+// array before appending.
+func (s *Slice[T]) Append(values ...T) {
+	if len(s.Values)+len(values) <= cap(s.Values) && s.owns(s.Values) {
+		s.Values = append(s.Values, values...)
+		return
+	}
+
+	grown := make([]T, len(s.Values), 2*(len(s.Values)+len(values)))
+	copy(grown, s.Values)
+	s.Values = append(grown, values...)
+	s.buffer = s.Values[:cap(s.Values)]
+}
+
+// owns will return whether values is backed by the array
+// allocated by Append.
+func (s *Slice[T]) owns(values []T) bool {
+	if cap(values) == 0 || cap(s.buffer) == 0 {
+		return false
+	}
+
+	return &values[:cap(values)][cap(values)-1] == &s.buffer[cap(s.buffer)-1]
+}
+
+// MarshalJSON will encode the remaining values of the
+// iterator as a JSON array. The iterator is not advanced.
+func (s Slice[T]) MarshalJSON() ([]byte, error) {
+	remaining := s.Values[s.index:]
+	if remaining == nil {
+		remaining = []T{}
+	}
+
+	return json.Marshal(remaining)
+}
+
+// UnmarshalJSON will decode a JSON array into the
+// iterator's values, resetting the iterator to the
+// first value.
+func (s *Slice[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	s.Values, s.index = values, 0
+	return nil
+}
+
+// Next will return the next recorded value if there is one.
+// Otherwise, the next value is pulled from the source iterator
+// and recorded.
+func (c *Cached[T]) Next() optional.Option[T] {
+	if c.index < len(c.cache.values) {
+		c.index++
+		return optional.Some(c.cache.values[c.index-1])
+	}
+
+	if c.cache.done {
+		return optional.None[T]()
+	}
+
+	opt := c.cache.source.Next()
+	if opt.IsSome() {
+		c.cache.values = append(c.cache.values, opt.Expect())
+		c.index++
+	} else {
+		c.cache.done = true
+	}
+
+	return opt
+}
+
+// Copy will return a new Cached iterator sharing the same
+// recorded values and source, starting from the point the
+// existing iterator is currently pointing to.
+func (c *Cached[T]) Copy() Iterator[T] { return &Cached[T]{cache: c.cache, index: c.index} }
+
+// Reset will rewind the iterator to the first recorded value.
+func (c *Cached[T]) Reset() { c.index = 0 }
+
+// Next will return the next value of the source iterator
+// paired with its index, or None if the source is exhausted.
+func (i *Indexed[T]) Next() optional.Option[optional.Pair[int, T]] {
+	return i.pair(i.source.Next())
+}
+
+func (i *Indexed[T]) pair(opt optional.Option[T]) optional.Option[optional.Pair[int, T]] {
+	if !opt.IsSome() {
+		return optional.None[optional.Pair[int, T]]()
+	}
+
+	i.index++
+	return optional.Some(optional.Pair[int, T]{First: i.index - 1, Second: opt.Expect()})
+}
+
+// Count will return the remaining number of elements of the
+// source iterator.
+func (s sizedIndexed[T]) Count() int { return s.source.(Enumerable[T]).Count() }
+
+// WaitForNext is the same as Next, except the source
+// iterator is waited on via its WaitForNext method.
+func (b blockingIndexed[T]) WaitForNext(ctx context.Context) optional.Option[optional.Pair[int, T]] {
+	return b.pair(b.source.(BlockingIterator[T]).WaitForNext(ctx))
+}
+
+// Count will return the remaining number of elements of the
+// source iterator.
+func (s sizedBlockingIndexed[T]) Count() int { return sizedIndexed[T]{s.Indexed}.Count() }
 
 // Next returns the result of waiting for the next value from the channel.
 // If the channel is closed, None is returned.
@@ -174,6 +525,55 @@ func (c Chan[T]) WaitForNext(ctx context.Context) optional.Option[T] {
 	return optional.None[T]()
 }
 
+// Next will wait until any channel receives a value,
+// returning it. If every channel is closed, None is
+// returned.
+func (s *Selection[T]) Next() optional.Option[T] {
+	return s.WaitForNext(context.Background())
+}
+
+// WaitForNext is the same as Next, except None is also
+// returned once the provided context is canceled.
+func (s *Selection[T]) WaitForNext(ctx context.Context) optional.Option[T] {
+	for len(s.cases) > 0 {
+		done := reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}
+		chosen, v, ok := reflect.Select(append(s.cases[:len(s.cases):len(s.cases)], done))
+		if chosen == len(s.cases) {
+			break
+		}
+
+		if !ok {
+			s.cases = append(s.cases[:chosen], s.cases[chosen+1:]...)
+			continue
+		}
+
+		// A nil interface value cannot be asserted to T, so
+		// the zero value is used instead
+		t, _ := v.Interface().(T)
+		return optional.Some(t)
+	}
+
+	return optional.None[T]()
+}
+
+// Next will wait on the rate limiter before returning the
+// next value of the source iterator.
+func (t *Throttled[T]) Next() optional.Option[T] {
+	return t.WaitForNext(context.Background())
+}
+
+// WaitForNext will wait on the rate limiter before waiting
+// on the next value of the source iterator. If the provided
+// context is canceled, or the limiter's wait would exceed
+// the context's deadline, None is returned.
+func (t *Throttled[T]) WaitForNext(ctx context.Context) optional.Option[T] {
+	if err := t.limiter.Wait(ctx); err != nil {
+		return optional.None[T]()
+	}
+
+	return WaitForNext(ctx, t.source)
+}
+
 // Next will return the result of calling f if it is not nil.
 // Otherwise, None is always returned.
 func (f Func[T]) Next() optional.Option[T] {
@@ -184,6 +584,14 @@ func (f Func[T]) Next() optional.Option[T] {
 	return optional.None[T]()
 }
 
+func recovered(r any) error {
+	if err, ok := r.(error); ok {
+		return fmt.Errorf("iterator: recovered from panic: %w", err)
+	}
+
+	return fmt.Errorf("iterator: recovered from panic: %v", r)
+}
+
 func waitForNext[T any](ctx context.Context, iter Iterator[T]) optional.Option[T] {
 	ch := make(chan optional.Option[T], 1)
 	go func() {
@@ -2,11 +2,16 @@ package iterator_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/standoffvenus/functional/v2/pkg/iterator"
 	"github.com/standoffvenus/functional/v2/pkg/optional"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
 )
 
 var Values []int = []int{4, 9, 13}
@@ -53,6 +58,190 @@ func TestSliceWaitForNext(t *testing.T) {
 	AssertWaitForNextIsNone[int](t, ctx, iter)
 }
 
+func TestSliceRange(t *testing.T) {
+	iter := iterator.SliceRange(Values, 1, 3)
+
+	assert.Equal(t, 2, iter.Count())
+	AssertIteratorMatches[int](t, iter, Values[1:3])
+	AssertNextIsNone[int](t, iter)
+}
+
+func TestSliceRangeEmpty(t *testing.T) {
+	iter := iterator.SliceRange(Values, 2, 2)
+
+	assert.Equal(t, 0, iter.Count())
+	AssertNextIsNone[int](t, iter)
+}
+
+func TestSliceRangeCopy(t *testing.T) {
+	iter := iterator.SliceRange(Values, 1, 2)
+
+	AssertIteratorMatches(t, iter.Copy(), Values[1:2])
+}
+
+func TestSliceRangePanicsOnInvalidRange(t *testing.T) {
+	assert.Panics(t, func() { iterator.SliceRange(Values, -1, 1) })
+	assert.Panics(t, func() { iterator.SliceRange(Values, 2, 1) })
+	assert.Panics(t, func() { iterator.SliceRange(Values, 0, len(Values)+1) })
+}
+
+func TestSliceAppend(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: Values[:1:1]}
+	AssertIteratorMatches[int](t, iter, Values[:1])
+	AssertNextIsNone[int](t, iter)
+
+	iter.Append(Values[1:]...)
+	assert.Equal(t, len(Values)-1, iter.Count())
+	AssertIteratorMatches[int](t, iter, Values[1:])
+	AssertNextIsNone[int](t, iter)
+}
+
+func TestSliceAppendDoesNotModifyCallerSlice(t *testing.T) {
+	values := make([]int, 2, 4)
+	iter := &iterator.Slice[int]{Values: values}
+	iter.Append(42)
+
+	assert.Equal(t, []int{0, 0, 0}, values[:3])
+	AssertIteratorMatches[int](t, iter, []int{0, 0, 42})
+}
+
+func TestSliceAppendReusesOwnBuffer(t *testing.T) {
+	iter := &iterator.Slice[int]{}
+	iter.Append(1)
+	values := iter.Values
+	iter.Append(2)
+
+	assert.Equal(t, &values[0], &iter.Values[0])
+	AssertIteratorMatches[int](t, iter, []int{1, 2})
+}
+
+func TestSliceRangeAppendDoesNotModifyCallerSlice(t *testing.T) {
+	values := []int{1, 2, 3, 4}
+	iter := iterator.SliceRange(values, 0, 2)
+	iter.Append(99)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, values)
+	AssertIteratorMatches[int](t, iter, []int{1, 2, 99})
+}
+
+func TestSliceCopyAppendIsIndependent(t *testing.T) {
+	iter := &iterator.Slice[int]{}
+	iter.Append(1, 2)
+	copyIter := iter.Copy().(*iterator.Slice[int])
+
+	copyIter.Append(3)
+	iter.Append(4)
+
+	AssertIteratorMatches[int](t, iter, []int{1, 2, 4})
+	AssertIteratorMatches[int](t, copyIter, []int{1, 2, 3})
+}
+
+func TestSliceMarshalJSON(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: Values}
+	_ = iter.Next()
+
+	data, err := json.Marshal(iter)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[9, 13]`, string(data))
+	assert.Equal(t, len(Values)-1, iter.Count())
+}
+
+func TestSliceMarshalJSONEmbedded(t *testing.T) {
+	response := struct {
+		Items iterator.Slice[int] `json:"items"`
+	}{Items: iterator.Slice[int]{}}
+
+	data, err := json.Marshal(response)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"items": []}`, string(data))
+}
+
+func TestSliceUnmarshalJSON(t *testing.T) {
+	var iter iterator.Slice[int]
+
+	assert.NoError(t, json.Unmarshal([]byte(`[4, 9, 13]`), &iter))
+	AssertIteratorMatches[int](t, &iter, Values)
+	AssertNextIsNone[int](t, &iter)
+}
+
+func TestSliceUnmarshalJSONInvalid(t *testing.T) {
+	var iter iterator.Slice[int]
+
+	assert.Error(t, json.Unmarshal([]byte(`{}`), &iter))
+}
+
+func TestSelect(t *testing.T) {
+	a, b := iterator.SendTo(1, 2), iterator.SendTo(3)
+	iter := iterator.Select(a, b)
+
+	var values []int
+	for opt := iter.Next(); opt.IsSome(); opt = iter.Next() {
+		values = append(values, opt.Expect())
+	}
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, values)
+	AssertNextIsNone[int](t, iter)
+}
+
+func TestSelectWaitForNext(t *testing.T) {
+	ctx := context.Background()
+	a, b := make(chan int), make(chan int)
+	iter := iterator.Select[int](a, b)
+
+	go func() {
+		b <- 42
+		close(a)
+		close(b)
+	}()
+
+	assert.Equal(t, 42, iter.WaitForNext(ctx).Expect())
+	AssertWaitForNextIsNone[int](t, ctx, iter)
+}
+
+func TestSelectWaitForNextOnCanceledContext(t *testing.T) {
+	ctx := canceled()
+	iter := iterator.Select[int](make(chan int))
+
+	AssertWaitForNextIsNone[int](t, ctx, iter)
+}
+
+func TestSelectNilInterfaceValue(t *testing.T) {
+	iter := iterator.Select(iterator.SendTo[error](nil))
+
+	assert.Equal(t, optional.Some[error](nil), iter.Next())
+}
+
+func TestSelectNoChannels(t *testing.T) {
+	AssertNextIsNone[int](t, iterator.Select[int]())
+}
+
+func TestRateLimited(t *testing.T) {
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	iter := iterator.RateLimited[int](&iterator.Slice[int]{Values: Values}, limiter)
+
+	AssertIteratorMatches[int](t, iter, Values)
+	AssertNextIsNone[int](t, iter)
+}
+
+func TestRateLimitedWaits(t *testing.T) {
+	const Interval = 20 * time.Millisecond
+	limiter := rate.NewLimiter(rate.Every(Interval), 1)
+	iter := iterator.RateLimited[int](&iterator.Slice[int]{Values: Values}, limiter)
+
+	start := time.Now()
+	AssertIteratorMatches[int](t, iter, Values)
+	assert.GreaterOrEqual(t, time.Since(start), Interval*time.Duration(len(Values)-1))
+}
+
+func TestRateLimitedWaitForNextOnCanceledContext(t *testing.T) {
+	ctx := canceled()
+	limiter := rate.NewLimiter(rate.Every(time.Hour), 1)
+	iter := iterator.RateLimited[int](&iterator.Slice[int]{Values: Values}, limiter)
+
+	assert.Equal(t, Values[0], iter.WaitForNext(context.Background()).Expect())
+	AssertWaitForNextIsNone[int](t, ctx, iter)
+}
+
 // TestSendTo asserts that SendTo will return a closed
 // channel, ready for use as iterator.Chan.
 func TestSendTo(t *testing.T) {
@@ -113,6 +302,83 @@ func TestFuncNextOnNil(t *testing.T) {
 	AssertNextIsNone[int](t, iter)
 }
 
+func TestUnfold(t *testing.T) {
+	type Split = optional.Pair[string, optional.Option[string]]
+	iter := iterator.Unfold(optional.Some("a,b,c"), func(s optional.Option[string]) optional.Option[Split] {
+		if !s.IsSome() {
+			return optional.None[Split]()
+		}
+
+		if before, after, found := strings.Cut(s.Expect(), ","); found {
+			return optional.Some(Split{First: before, Second: optional.Some(after)})
+		}
+
+		return optional.Some(Split{First: s.Expect(), Second: optional.None[string]()})
+	})
+
+	AssertIteratorMatches(t, iter, []string{"a", "b", "c"})
+	AssertNextIsNone(t, iter)
+}
+
+func TestWithIndex(t *testing.T) {
+	iter := iterator.WithIndex[int](&iterator.Slice[int]{Values: Values})
+
+	for idx, v := range Values {
+		assert.Equal(t, optional.Pair[int, int]{First: idx, Second: v}, iter.Next().Expect())
+	}
+	AssertNextIsNone(t, iter)
+}
+
+func TestWithIndexPreservesCount(t *testing.T) {
+	iter := iterator.WithIndex[int](&iterator.Slice[int]{Values: Values})
+	sized, ok := iter.(iterator.Enumerable[optional.Pair[int, int]])
+
+	assert.True(t, ok)
+	assert.Equal(t, len(Values), sized.Count())
+	_ = iter.Next()
+	assert.Equal(t, len(Values)-1, sized.Count())
+}
+
+func TestWithIndexWithoutCount(t *testing.T) {
+	iter := iterator.WithIndex[int](iterator.Chan[int](iterator.SendTo(Values...)))
+	_, ok := iter.(iterator.Enumerable[optional.Pair[int, int]])
+
+	assert.False(t, ok)
+}
+
+func TestWithIndexNonBlocking(t *testing.T) {
+	iter := iterator.WithIndex[int](funcIteratorOf(Values))
+	_, isBlocking := iter.(iterator.BlockingIterator[optional.Pair[int, int]])
+	_, isSized := iter.(iterator.Enumerable[optional.Pair[int, int]])
+
+	assert.False(t, isBlocking)
+	assert.False(t, isSized)
+}
+
+func TestWithIndexSizedAndBlocking(t *testing.T) {
+	ctx := context.Background()
+	iter := iterator.WithIndex[int](&iterator.Slice[int]{Values: Values})
+	blocking, isBlocking := iter.(iterator.BlockingIterator[optional.Pair[int, int]])
+	sized, isSized := iter.(iterator.Enumerable[optional.Pair[int, int]])
+
+	assert.True(t, isBlocking)
+	assert.True(t, isSized)
+	assert.Equal(t, optional.Pair[int, int]{First: 0, Second: Values[0]}, blocking.WaitForNext(ctx).Expect())
+	assert.Equal(t, len(Values)-1, sized.Count())
+}
+
+func TestWithIndexWaitForNext(t *testing.T) {
+	ctx := context.Background()
+	iter := iterator.WithIndex[int](iterator.Chan[int](iterator.SendTo(Values...)))
+	blocking, ok := iter.(iterator.BlockingIterator[optional.Pair[int, int]])
+
+	assert.True(t, ok)
+	for idx, v := range Values {
+		assert.Equal(t, optional.Pair[int, int]{First: idx, Second: v}, blocking.WaitForNext(ctx).Expect())
+	}
+	AssertWaitForNextIsNone(t, ctx, blocking)
+}
+
 func TestWaitForNext(t *testing.T) {
 	ctx := context.Background()
 	iter := funcIteratorOf(Values)
@@ -135,6 +401,111 @@ func TestWaitForNextForeverBlocking(t *testing.T) {
 	assert.Equal(t, optional.None[int](), iterator.WaitForNext[int](ctx, iter))
 }
 
+func TestCache(t *testing.T) {
+	source := &iterator.Slice[int]{Values: Values}
+	iter := iterator.Cache[int](source)
+
+	AssertIteratorMatches[int](t, iter, Values)
+	AssertNextIsNone[int](t, iter)
+
+	iter.Reset()
+	AssertIteratorMatches[int](t, iter, Values)
+	AssertNextIsNone[int](t, iter)
+}
+
+func TestCachePullsSourceOnce(t *testing.T) {
+	calls := 0
+	source := iterator.Func[int](func() optional.Option[int] {
+		calls++
+		return optional.Some(calls)
+	})
+	iter := iterator.Cache[int](source)
+
+	AssertIteratorMatches[int](t, iter, []int{1, 2})
+	iter.Reset()
+	AssertIteratorMatches[int](t, iter, []int{1, 2, 3})
+	assert.Equal(t, 3, calls)
+}
+
+func TestCacheCopy(t *testing.T) {
+	iter := iterator.Cache[int](&iterator.Slice[int]{Values: Values})
+	copyIter := iter.Copy()
+
+	AssertIteratorMatches[int](t, iter, Values)
+	AssertNextIsNone[int](t, iter)
+	AssertIteratorMatches(t, copyIter, Values)
+	AssertNextIsNone(t, copyIter)
+}
+
+func TestGenerate(t *testing.T) {
+	fibonacci := iterator.Generate([2]int{0, 1}, func(s [2]int) (int, [2]int, bool) {
+		return s[0], [2]int{s[1], s[0] + s[1]}, s[0] < 10
+	})
+
+	AssertIteratorMatches(t, fibonacci, []int{0, 1, 1, 2, 3, 5, 8})
+	AssertNextIsNone(t, fibonacci)
+}
+
+func TestGenerateStopsCallingStep(t *testing.T) {
+	calls := 0
+	iter := iterator.Generate(0, func(s int) (int, int, bool) {
+		calls++
+		return s, s, false
+	})
+
+	AssertNextIsNone(t, iter)
+	AssertNextIsNone(t, iter)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRecover(t *testing.T) {
+	iter := iterator.Recover[int](&iterator.Slice[int]{Values: Values})
+
+	for _, v := range Values {
+		assert.Equal(t, optional.Ok(v), iter.Next().Expect())
+	}
+	AssertNextIsNone(t, iter)
+}
+
+func TestRecoverFromPanic(t *testing.T) {
+	calls := 0
+	iter := iterator.Recover[int](iterator.Func[int](func() optional.Option[int] {
+		if calls++; calls == 1 {
+			panic("bad element")
+		}
+
+		return optional.Some(calls)
+	}))
+
+	result := iter.Next().Expect()
+	assert.False(t, result.Ok())
+	assert.Error(t, result.Err())
+	assert.Equal(t, optional.Ok(2), iter.Next().Expect())
+}
+
+func TestRecoverFromPanicWithError(t *testing.T) {
+	var Error error = errors.New("error")
+	iter := iterator.Recover[int](iterator.Func[int](func() optional.Option[int] {
+		panic(Error)
+	}))
+
+	assert.ErrorIs(t, iter.Next().Expect().Err(), Error)
+}
+
+func TestRecoverNil(t *testing.T) {
+	AssertNextIsNone(t, iterator.Recover[int](nil))
+}
+
+func TestRecoverFromRepeatedPanics(t *testing.T) {
+	iter := iterator.Recover[int](iterator.Func[int](func() optional.Option[int] {
+		panic("always")
+	}))
+
+	for i := 0; i < 3; i++ {
+		assert.False(t, iter.Next().Expect().Ok())
+	}
+}
+
 func funcIteratorOf[T any](v []T) iterator.Func[T] {
 	ch := iterator.Send(v...)
 	defer close(ch)
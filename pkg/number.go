@@ -1,9 +1,12 @@
 package functional
 
 import (
+	"errors"
 	"math"
+	"sort"
 
 	"github.com/standoffvenus/functional/v2/pkg/iterator"
+	"github.com/standoffvenus/functional/v2/pkg/optional"
 )
 
 // Number represents all numeric types in Go.
@@ -18,11 +21,66 @@ type Rational interface {
 		~float32 | ~float64
 }
 
+// Ordered represents all types that support the
+// operators <, <=, >, and >=.
+type Ordered interface {
+	Rational | ~uintptr | ~string
+}
+
 // Sum will sum the elements of a numeric iterator.
 func Sum[T Number](iter iterator.Iterator[T]) T {
 	return Reduce(iter, func(accum, cur T) T { return accum + cur })
 }
 
+// ErrOverflow is returned from CheckedSum when the sum of an
+// iterator cannot be represented by its type.
+var ErrOverflow = errors.New("functional: sum overflows")
+
+// CheckedSum will sum the elements of a numeric iterator,
+// returning ErrOverflow as soon as the sum overflows. For
+// integers, the sum overflows if it would wrap around; for
+// floating-point numbers, the sum overflows if it becomes
+// infinite or NaN.
+func CheckedSum[T Rational](iter iterator.Iterator[T]) optional.Result[T] {
+	var sum T
+	half := 0.5
+	isFloat := T(half) != 0
+
+	overflowed := false
+	ForEach(iter, func(x T, stop Break) {
+		total := sum + x
+		if isFloat {
+			overflowed = math.IsInf(float64(total), 0) || math.IsNaN(float64(total))
+		} else {
+			overflowed = (x > 0 && total < sum) || (x < 0 && total > sum)
+		}
+
+		if sum = total; overflowed {
+			stop()
+		}
+	})
+
+	if overflowed {
+		return optional.Err[T](ErrOverflow)
+	}
+
+	return optional.Ok(sum)
+}
+
+// AddScalar will add the provided addend to all elements
+// in the iterator, returning an iterator containing the
+// sums.
+func AddScalar[T Number](iter iterator.Iterator[T], addend T) iterator.Iterator[T] {
+	return Map(iter, func(x T) T { return x + addend })
+}
+
+// SubtractScalar will subtract the provided subtrahend
+// from all elements in the iterator, returning an iterator
+// containing the differences.
+func SubtractScalar[T Number](iter iterator.Iterator[T], subtrahend T) iterator.Iterator[T] {
+	return Map(iter, func(x T) T { return x - subtrahend })
+}
+
 // MultiplyScalar will multiply all the elements of a
 // numeric iterator together to produce their product.
 func MultiplyScalar[T Number](iter iterator.Iterator[T]) T {
@@ -49,6 +107,29 @@ func DotProduct[T Number](a, b iterator.Enumerable[T]) T {
 	})
 }
 
+// AddVector will add each value of both iterators,
+// returning an iterator containing their sums. If the
+// iterators are different sizes, AddVector will panic.
+func AddVector[T Number](a, b iterator.Enumerable[T]) iterator.Iterator[T] {
+	if a.Count() != b.Count() {
+		panic("functional: vector addition on iterators with different dimensions")
+	}
+
+	return Map[T](a, func(x T) T { return x + b.Next().Expect() })
+}
+
+// SubtractVector will subtract each value of b from the
+// corresponding value of a, returning an iterator containing
+// their differences. If the iterators are different sizes,
+// SubtractVector will panic.
+func SubtractVector[T Number](a, b iterator.Enumerable[T]) iterator.Iterator[T] {
+	if a.Count() != b.Count() {
+		panic("functional: vector subtraction on iterators with different dimensions")
+	}
+
+	return Map[T](a, func(x T) T { return x - b.Next().Expect() })
+}
+
 // Square will square each value in the iterator, returning
 // an iterator containing the squares.
 func Square[T Number](iter iterator.Iterator[T]) iterator.Iterator[T] {
@@ -74,3 +155,232 @@ func Quadruple[T Number](iter iterator.Iterator[T]) iterator.Iterator[T] {
 func ToPower[T Rational](iter iterator.Iterator[T], exp T) iterator.Iterator[T] {
 	return Map(iter, func(x T) T { return T(math.Pow(float64(x), float64(exp))) })
 }
+
+// Abs will take the absolute value of each element in the
+// iterator, returning an iterator containing the results.
+// For unsigned types, Abs is a no-op.
+//
+// Like Go's negation operator, Abs overflows on the minimum
+// value of a signed integer type (e.g. math.MinInt64), which
+// is returned unchanged.
+func Abs[T Rational](iter iterator.Iterator[T]) iterator.Iterator[T] {
+	return Map(iter, func(x T) T {
+		if x < 0 {
+			return -x
+		}
+
+		return x
+	})
+}
+
+// Mean will return the arithmetic mean of the provided
+// values, or None if there are no values.
+func Mean[T Rational](list []T) optional.Option[float64] {
+	if len(list) == 0 {
+		return optional.None[float64]()
+	}
+
+	sum := float64(0)
+	for _, x := range list {
+		sum += float64(x)
+	}
+
+	return optional.Some(sum / float64(len(list)))
+}
+
+// Median will return the median of the provided values,
+// or None if there are no values. Median is equivalent
+// to Percentile(list, 50).
+func Median[T Rational](list []T) optional.Option[float64] {
+	return Percentile(list, 50)
+}
+
+// Percentile will return the p-th percentile of the
+// provided values, or None if there are no values. The
+// provided slice is not modified; a sorted copy is made.
+//
+// Percentile linearly interpolates between the two closest
+// ranks: the percentile lies at rank (p / 100) * (len(list) - 1)
+// of the sorted values. If p is not within [0, 100] (or is
+// NaN), Percentile will panic.
+func Percentile[T Rational](list []T, p float64) optional.Option[float64] {
+	if math.IsNaN(p) || p < 0 || p > 100 {
+		bork("percentile %v outside of range [0, 100]", p)
+	}
+
+	if len(list) == 0 {
+		return optional.None[float64]()
+	}
+
+	values := make([]float64, len(list))
+	for idx, x := range list {
+		values[idx] = float64(x)
+	}
+	sort.Float64s(values)
+
+	rank := (p / 100) * float64(len(values)-1)
+	lower, upper := int(math.Floor(rank)), int(math.Ceil(rank))
+	weight := rank - float64(lower)
+
+	return optional.Some(values[lower] + weight*(values[upper]-values[lower]))
+}
+
+// Histogram will count the values of the iterator falling
+// into each bucket defined by boundaries, which must be
+// non-empty and sorted in ascending order. Histogram returns
+// len(boundaries) + 1 counts:
+//  - counts[0] is the number of values less than boundaries[0]
+//  - counts[i] is the number of values "x" such that
+//    boundaries[i-1] <= x < boundaries[i]
+//  - counts[len(boundaries)] is the number of values greater
+//    than or equal to the last boundary
+// If boundaries is empty or unsorted, Histogram will panic.
+func Histogram[T Rational](iter iterator.Iterator[T], boundaries []T) []int {
+	if len(boundaries) == 0 {
+		bork("empty boundaries passed to histogram")
+	}
+
+	for idx := 1; idx < len(boundaries); idx++ {
+		if boundaries[idx] < boundaries[idx-1] {
+			bork("unsorted boundaries passed to histogram")
+		}
+	}
+
+	counts := make([]int, len(boundaries)+1)
+	ForEach(iter, func(x T, _ Break) {
+		counts[sort.Search(len(boundaries), func(i int) bool { return x < boundaries[i] })]++
+	})
+
+	return counts
+}
+
+// RunningMax will return an iterator containing, for each
+// value of the provided iterator, the maximum of all values
+// retrieved so far.
+func RunningMax[T Ordered](iter iterator.Iterator[T]) iterator.Iterator[T] {
+	return running(iter, func(extreme, x T) bool { return x > extreme })
+}
+
+// RunningMin will return an iterator containing, for each
+// value of the provided iterator, the minimum of all values
+// retrieved so far.
+func RunningMin[T Ordered](iter iterator.Iterator[T]) iterator.Iterator[T] {
+	return running(iter, func(extreme, x T) bool { return x < extreme })
+}
+
+// ArgMax will return the index and value of the maximum of
+// the provided values, or None if there are no values. If
+// the maximum occurs more than once, its first index is
+// returned.
+func ArgMax[T Ordered](list []T) optional.Option[optional.Pair[int, T]] {
+	return argExtreme(list, func(extreme, x T) bool { return x > extreme })
+}
+
+// ArgMin will return the index and value of the minimum of
+// the provided values, or None if there are no values. If
+// the minimum occurs more than once, its first index is
+// returned.
+func ArgMin[T Ordered](list []T) optional.Option[optional.Pair[int, T]] {
+	return argExtreme(list, func(extreme, x T) bool { return x < extreme })
+}
+
+// IsMonotonic will return whether the provided iterator's
+// values are non-decreasing and whether they are
+// non-increasing, retrieving each value once. If both are
+// true, all values are equal (or there are fewer than two
+// values). IsMonotonic stops iterating once both are false.
+func IsMonotonic[T Ordered](iter iterator.Iterator[T]) (increasing bool, decreasing bool) {
+	increasing, decreasing = true, true
+
+	var previous optional.Option[T]
+	ForEach(iter, func(x T, stop Break) {
+		if previous.IsSome() {
+			increasing = increasing && x >= previous.Expect()
+			decreasing = decreasing && x <= previous.Expect()
+			if !increasing && !decreasing {
+				stop()
+			}
+		}
+
+		previous = optional.Some(x)
+	})
+
+	return increasing, decreasing
+}
+
+// StatsSummary holds summary statistics of numeric values,
+// as returned from Stats.
+type StatsSummary[T Rational] struct {
+	Count    int
+	Sum      T
+	Min, Max T
+	Mean     float64
+}
+
+// Stats will return the count, sum, minimum, maximum, and
+// arithmetic mean of the provided iterator's values in a
+// single pass, or None if there are no values. Like Mean,
+// the mean is computed using float64 so that it does not
+// overflow with the sum.
+func Stats[T Rational](iter iterator.Iterator[T]) optional.Option[StatsSummary[T]] {
+	var summary StatsSummary[T]
+	total := float64(0)
+	ForEach(iter, func(x T, _ Break) {
+		if summary.Count == 0 || x < summary.Min {
+			summary.Min = x
+		}
+
+		if summary.Count == 0 || x > summary.Max {
+			summary.Max = x
+		}
+
+		summary.Count++
+		summary.Sum += x
+		total += float64(x)
+	})
+
+	if summary.Count == 0 {
+		return optional.None[StatsSummary[T]]()
+	}
+
+	summary.Mean = total / float64(summary.Count)
+	return optional.Some(summary)
+}
+
+// argExtreme will return the index and value of the extreme
+// of the provided values. The extreme is replaced by a value
+// "x" if replace(extreme, x) holds true.
+func argExtreme[T any](list []T, replace func(T, T) bool) optional.Option[optional.Pair[int, T]] {
+	if len(list) == 0 {
+		return optional.None[optional.Pair[int, T]]()
+	}
+
+	index := 0
+	for i := 1; i < len(list); i++ {
+		if replace(list[index], list[i]) {
+			index = i
+		}
+	}
+
+	return optional.Some(optional.Pair[int, T]{First: index, Second: list[index]})
+}
+
+// running will return an iterator containing the current
+// extreme of the provided iterator's values. The extreme
+// is replaced by a value "x" if replace(extreme, x) holds
+// true.
+func running[T any](iter iterator.Iterator[T], replace func(T, T) bool) iterator.Iterator[T] {
+	var extreme optional.Option[T]
+
+	return iterator.Func[T](func() optional.Option[T] {
+		if opt := next(iter); opt.IsSome() {
+			if !extreme.IsSome() || replace(extreme.Expect(), opt.Expect()) {
+				extreme = opt
+			}
+
+			return extreme
+		}
+
+		return optional.None[T]()
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	functional "github.com/standoffvenus/functional/v2/pkg"
 	"github.com/standoffvenus/functional/v2/pkg/iterator"
+	"github.com/standoffvenus/functional/v2/pkg/optional"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,6 +26,51 @@ func TestSum(t *testing.T) {
 	)
 }
 
+func TestCheckedSum(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{1, -2, 3}}
+
+	assert.Equal(t, 2, functional.CheckedSum[int](iter).Expect())
+}
+
+func TestCheckedSumOverflow(t *testing.T) {
+	tests := map[string]func() error{
+		"Signed": func() error {
+			return functional.CheckedSum[int8](&iterator.Slice[int8]{Values: []int8{100, 27, 1}}).Err()
+		},
+		"Signed Negative": func() error {
+			return functional.CheckedSum[int8](&iterator.Slice[int8]{Values: []int8{-100, -28, -1}}).Err()
+		},
+		"Unsigned": func() error {
+			return functional.CheckedSum[uint8](&iterator.Slice[uint8]{Values: []uint8{200, 56}}).Err()
+		},
+		"Float": func() error {
+			return functional.CheckedSum[float64](&iterator.Slice[float64]{Values: []float64{math.MaxFloat64, math.MaxFloat64}}).Err()
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, test(), functional.ErrOverflow)
+		})
+	}
+}
+
+func TestCheckedSumAtLimit(t *testing.T) {
+	iter := &iterator.Slice[int8]{Values: []int8{100, 27}}
+
+	assert.Equal(t, int8(math.MaxInt8), functional.CheckedSum[int8](iter).Expect())
+}
+
+func TestAddScalar(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{-1, 0, 1}}
+	AssertIteratorEqual(t, []int{1, 2, 3}, functional.AddScalar[int](iter, 2))
+}
+
+func TestSubtractScalar(t *testing.T) {
+	iter := &iterator.Slice[complex128]{Values: []complex128{1 + 1i, 2}}
+	AssertIteratorEqual(t, []complex128{0, 1 - 1i}, functional.SubtractScalar[complex128](iter, 1+1i))
+}
+
 func TestMultiplyScalar(t *testing.T) {
 	const factor float64 = 2.5
 	quick.Check(
@@ -75,6 +121,38 @@ func TestDotProductPanicsOnDifferentDimensions(t *testing.T) {
 	})
 }
 
+func TestAddVector(t *testing.T) {
+	a := &iterator.Slice[float64]{Values: []float64{6, -2, -1}}
+	b := &iterator.Slice[float64]{Values: []float64{2, 10, 2}}
+
+	AssertIteratorEqual(t, []float64{8, 8, 1}, functional.AddVector[float64](a, b))
+}
+
+func TestAddVectorPanicsOnDifferentDimensions(t *testing.T) {
+	assert.Panics(t, func() {
+		a := &iterator.Slice[int]{}
+		b := &iterator.Slice[int]{Values: []int{42}}
+
+		functional.AddVector[int](a, b)
+	})
+}
+
+func TestSubtractVector(t *testing.T) {
+	a := &iterator.Slice[float64]{Values: []float64{6, -2, -1}}
+	b := &iterator.Slice[float64]{Values: []float64{2, 10, 2}}
+
+	AssertIteratorEqual(t, []float64{4, -12, -3}, functional.SubtractVector[float64](a, b))
+}
+
+func TestSubtractVectorPanicsOnDifferentDimensions(t *testing.T) {
+	assert.Panics(t, func() {
+		a := &iterator.Slice[int]{}
+		b := &iterator.Slice[int]{Values: []int{42}}
+
+		functional.SubtractVector[int](a, b)
+	})
+}
+
 func TestSquare(t *testing.T) {
 	iter := &iterator.Slice[float64]{Values: []float64{1, 2, 3, 4}}
 	squaredIterator := functional.Square[float64](iter)
@@ -123,3 +201,144 @@ func TestToPower(t *testing.T) {
 
 	AssertIteratorEqual(t, expected, toPowerIterator)
 }
+
+func TestAbs(t *testing.T) {
+	iter := &iterator.Slice[float64]{Values: []float64{-1.5, 0, 2, -4}}
+	absIterator := functional.Abs[float64](iter)
+
+	AssertIteratorEqual(t, []float64{1.5, 0, 2, 4}, absIterator)
+}
+
+func TestAbsUnsigned(t *testing.T) {
+	iter := &iterator.Slice[uint]{Values: []uint{0, 1, math.MaxUint}}
+	absIterator := functional.Abs[uint](iter)
+
+	AssertIteratorEqual(t, []uint{0, 1, math.MaxUint}, absIterator)
+}
+
+func TestAbsOverflow(t *testing.T) {
+	iter := &iterator.Slice[int8]{Values: []int8{math.MinInt8}}
+	absIterator := functional.Abs[int8](iter)
+
+	AssertIteratorEqual(t, []int8{math.MinInt8}, absIterator)
+}
+
+func TestMean(t *testing.T) {
+	assert.Equal(t, 2.5, functional.Mean([]int{1, 2, 3, 4}).Expect())
+}
+
+func TestMeanWithNoValues(t *testing.T) {
+	assert.False(t, functional.Mean([]int{}).IsSome())
+}
+
+func TestMedian(t *testing.T) {
+	values := []int{9, 1, 5}
+	assert.Equal(t, float64(5), functional.Median(values).Expect())
+	assert.Equal(t, []int{9, 1, 5}, values)
+}
+
+func TestMedianEvenLength(t *testing.T) {
+	assert.Equal(t, 2.5, functional.Median([]int{4, 1, 3, 2}).Expect())
+}
+
+func TestMedianWithNoValues(t *testing.T) {
+	assert.False(t, functional.Median[int](nil).IsSome())
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{40, 10, 30, 20, 50}
+	assert.Equal(t, float64(10), functional.Percentile(values, 0).Expect())
+	assert.Equal(t, float64(20), functional.Percentile(values, 25).Expect())
+	assert.Equal(t, float64(34), functional.Percentile(values, 60).Expect())
+	assert.Equal(t, float64(50), functional.Percentile(values, 100).Expect())
+}
+
+func TestPercentilePanicsOutsideOfRange(t *testing.T) {
+	assert.Panics(t, func() { functional.Percentile([]int{1}, 101) })
+	assert.Panics(t, func() { functional.Percentile([]int{1}, -1) })
+}
+
+func TestPercentilePanicsOnNaN(t *testing.T) {
+	assert.Panics(t, func() { functional.Percentile([]int{1, 2}, math.NaN()) })
+}
+
+func TestHistogram(t *testing.T) {
+	iter := &iterator.Slice[float64]{Values: []float64{-5, 0, 0.5, 1, 9.9, 10, 42}}
+	counts := functional.Histogram[float64](iter, []float64{0, 1, 10})
+
+	assert.Equal(t, []int{1, 2, 2, 2}, counts)
+}
+
+func TestHistogramPanicsOnInvalidBoundaries(t *testing.T) {
+	assert.Panics(t, func() { functional.Histogram[int](&iterator.Slice[int]{}, nil) })
+	assert.Panics(t, func() { functional.Histogram[int](&iterator.Slice[int]{}, []int{2, 1}) })
+}
+
+func TestRunningMax(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{3, 1, 4, 1, 5, 2}}
+
+	assert.Equal(t, []int{3, 3, 4, 4, 5, 5}, functional.Collect(functional.RunningMax[int](iter)))
+}
+
+func TestRunningMin(t *testing.T) {
+	iter := &iterator.Slice[string]{Values: []string{"c", "d", "a", "b"}}
+
+	assert.Equal(t, []string{"c", "c", "a", "a"}, functional.Collect(functional.RunningMin[string](iter)))
+}
+
+func TestArgMax(t *testing.T) {
+	assert.Equal(t,
+		optional.Some(optional.Pair[int, int]{First: 2, Second: 5}),
+		functional.ArgMax([]int{3, 1, 5, 2, 5}))
+}
+
+func TestArgMaxNoValues(t *testing.T) {
+	assert.True(t, functional.ArgMax[int](nil).IsNone())
+}
+
+func TestArgMin(t *testing.T) {
+	assert.Equal(t,
+		optional.Some(optional.Pair[int, string]{First: 1, Second: "a"}),
+		functional.ArgMin([]string{"c", "a", "b", "a"}))
+}
+
+func TestArgMinNoValues(t *testing.T) {
+	assert.True(t, functional.ArgMin([]float64{}).IsNone())
+}
+
+func TestStats(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{4, -2, 9, 1}}
+
+	assert.Equal(t,
+		functional.StatsSummary[int]{Count: 4, Sum: 12, Min: -2, Max: 9, Mean: 3},
+		functional.Stats[int](iter).Expect())
+}
+
+func TestStatsNoValues(t *testing.T) {
+	assert.True(t, functional.Stats[float64](&iterator.Slice[float64]{}).IsNone())
+}
+
+func TestIsMonotonic(t *testing.T) {
+	for _, test := range []struct {
+		values                 []int
+		increasing, decreasing bool
+	}{
+		{nil, true, true},
+		{[]int{1, 1, 1}, true, true},
+		{[]int{1, 2, 2, 3}, true, false},
+		{[]int{3, 2, 2, 1}, false, true},
+		{[]int{1, 3, 2}, false, false},
+	} {
+		increasing, decreasing := functional.IsMonotonic[int](&iterator.Slice[int]{Values: test.values})
+
+		assert.Equal(t, test.increasing, increasing, test.values)
+		assert.Equal(t, test.decreasing, decreasing, test.values)
+	}
+}
+
+func TestIsMonotonicStopsOnceUnordered(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{1, 3, 2, 4, 5}}
+	functional.IsMonotonic[int](iter)
+
+	assert.Equal(t, 2, iter.Count())
+}
@@ -34,6 +34,12 @@ func (o Option[T]) IsSome() bool {
 	return o.some
 }
 
+// IsNone returns true iff the option does
+// not have a value.
+func (o Option[T]) IsNone() bool {
+	return !o.some
+}
+
 // Get will retrieve the option's value.
 // If None, the returned value is the zero
 // value of T.
@@ -61,3 +67,72 @@ func (o Option[T]) String() string {
 
 	return fmt.Sprintf("None")
 }
+
+// Format will return the option's value formatted
+// using f, or "None" if the option has no value.
+func (o Option[T]) Format(f func(T) string) string {
+	if o.IsSome() {
+		return f(o.Expect())
+	}
+
+	return "None"
+}
+
+// AndThen will return the result of calling fn with the
+// option's value if the option is Some. Otherwise, None
+// is returned without calling fn.
+func AndThen[From, To any](o Option[From], fn func(From) Option[To]) Option[To] {
+	if o.IsSome() {
+		return fn(o.value)
+	}
+
+	return None[To]()
+}
+
+// Apply will return Some of the result of calling the
+// function in of with the value of ov if both options
+// are Some. Otherwise, None is returned.
+func Apply[From, To any](of Option[func(From) To], ov Option[From]) Option[To] {
+	if of.IsSome() && ov.IsSome() {
+		return Some(of.value(ov.value))
+	}
+
+	return None[To]()
+}
+
+// Collect will return Some of every option's value if
+// every option is Some. Otherwise, None is returned. If
+// no options are provided, Some of an empty slice is
+// returned.
+func Collect[T any](opts []Option[T]) Option[[]T] {
+	values := make([]T, 0, len(opts))
+	for _, o := range opts {
+		if !o.IsSome() {
+			return None[[]T]()
+		}
+
+		values = append(values, o.value)
+	}
+
+	return Some(values)
+}
+
+// Zip will return Some of both options' values if both
+// options are Some. Otherwise, None is returned.
+func Zip[A, B any](a Option[A], b Option[B]) Option[Pair[A, B]] {
+	if a.IsSome() && b.IsSome() {
+		return Some(Pair[A, B]{First: a.value, Second: b.value})
+	}
+
+	return None[Pair[A, B]]()
+}
+
+// Zip3 is the same as Zip, except it combines three
+// options instead of two.
+func Zip3[A, B, C any](a Option[A], b Option[B], c Option[C]) Option[Triple[A, B, C]] {
+	if a.IsSome() && b.IsSome() && c.IsSome() {
+		return Some(Triple[A, B, C]{First: a.value, Second: b.value, Third: c.value})
+	}
+
+	return None[Triple[A, B, C]]()
+}
@@ -23,6 +23,11 @@ func TestNone(t *testing.T) {
 	assert.Panics(t, func() { v.Expect() })
 }
 
+func TestIsNone(t *testing.T) {
+	assert.True(t, optional.None[int]().IsNone())
+	assert.False(t, optional.Some(42).IsNone())
+}
+
 func TestOptionZeroIsNone(t *testing.T) {
 	assert.False(t, optional.Option[int]{}.IsSome())
 }
@@ -37,3 +42,89 @@ func TestOptionStringWithValue(t *testing.T) {
 	v := optional.Some(Value)
 	assert.Equal(t, strconv.FormatInt(Value, 10), v.String())
 }
+
+func TestOptionFormatWithNoValue(t *testing.T) {
+	v := optional.None[int]()
+	assert.Equal(t, "None", v.Format(strconv.Itoa))
+}
+
+func TestOptionFormatWithValue(t *testing.T) {
+	const Value = 42
+	v := optional.Some(Value)
+	assert.Equal(t, "0x2a", v.Format(func(x int) string { return "0x" + strconv.FormatInt(int64(x), 16) }))
+}
+
+func TestAndThen(t *testing.T) {
+	users := map[string]map[string]string{"ann": {"email": "ann@example.com"}}
+	lookup := func(m map[string]string, key string) optional.Option[string] {
+		if v, ok := m[key]; ok {
+			return optional.Some(v)
+		}
+
+		return optional.None[string]()
+	}
+
+	user := func(name string) optional.Option[map[string]string] {
+		if u, ok := users[name]; ok {
+			return optional.Some(u)
+		}
+
+		return optional.None[map[string]string]()
+	}
+
+	email := func(u map[string]string) optional.Option[string] { return lookup(u, "email") }
+	phone := func(u map[string]string) optional.Option[string] { return lookup(u, "phone") }
+
+	assert.Equal(t, optional.Some("ann@example.com"), optional.AndThen(user("ann"), email))
+	assert.True(t, optional.AndThen(user("ann"), phone).IsNone())
+	assert.True(t, optional.AndThen(user("bo"), email).IsNone())
+}
+
+func TestApply(t *testing.T) {
+	add := func(p optional.Pair[int, int]) int { return p.First + p.Second }
+
+	assert.Equal(t, optional.Some(5), optional.Apply(optional.Some(add), optional.Zip(optional.Some(2), optional.Some(3))))
+}
+
+func TestApplyWithNone(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+
+	assert.True(t, optional.Apply(optional.None[func(int) int](), optional.Some(1)).IsNone())
+	assert.True(t, optional.Apply(optional.Some(double), optional.None[int]()).IsNone())
+}
+
+func TestCollect(t *testing.T) {
+	collected := optional.Collect([]optional.Option[int]{optional.Some(1), optional.Some(2)})
+	assert.Equal(t, []int{1, 2}, collected.Expect())
+}
+
+func TestCollectWithNone(t *testing.T) {
+	collected := optional.Collect([]optional.Option[int]{optional.Some(1), optional.None[int]()})
+	assert.False(t, collected.IsSome())
+}
+
+func TestCollectWithNoOptions(t *testing.T) {
+	collected := optional.Collect[int](nil)
+	assert.NotNil(t, collected.Expect())
+	assert.Empty(t, collected.Expect())
+}
+
+func TestZip(t *testing.T) {
+	zipped := optional.Zip(optional.Some(42), optional.Some("value"))
+	assert.Equal(t, optional.Pair[int, string]{First: 42, Second: "value"}, zipped.Expect())
+}
+
+func TestZipWithNone(t *testing.T) {
+	assert.False(t, optional.Zip(optional.Some(42), optional.None[string]()).IsSome())
+	assert.False(t, optional.Zip(optional.None[int](), optional.Some("value")).IsSome())
+}
+
+func TestZip3(t *testing.T) {
+	zipped := optional.Zip3(optional.Some(42), optional.Some("value"), optional.Some(true))
+	assert.Equal(t, optional.Triple[int, string, bool]{First: 42, Second: "value", Third: true}, zipped.Expect())
+}
+
+func TestZip3WithNone(t *testing.T) {
+	zipped := optional.Zip3(optional.Some(42), optional.Some("value"), optional.None[bool]())
+	assert.False(t, zipped.IsSome())
+}
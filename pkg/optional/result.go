@@ -1,5 +1,10 @@
 package optional
 
+import (
+	"encoding/json"
+	"errors"
+)
+
 // Ok will return an OK result with the given
 // value.
 func Ok[T any](t T) Result[T] {
@@ -50,6 +55,27 @@ func (r Result[T]) Expect() T {
 	return r.opt.value
 }
 
+// Or will return the result if it is OK. Otherwise,
+// other is returned.
+func (r Result[T]) Or(other Result[T]) Result[T] {
+	if r.Ok() {
+		return r
+	}
+
+	return other
+}
+
+// GetOrElse will return the value stored in the result
+// if it is OK. Otherwise, the result of calling fn with
+// the result's error is returned.
+func (r Result[T]) GetOrElse(fn func(error) T) T {
+	if r.Ok() {
+		return r.opt.value
+	}
+
+	return fn(r.err)
+}
+
 // String will return the result's value formatted using fmt.Sprintf,
 // or the error string if the result is erroneous.
 func (r Result[T]) String() string {
@@ -59,3 +85,49 @@ func (r Result[T]) String() string {
 
 	return r.err.Error()
 }
+
+// MarshalJSON will encode an OK result as its value. An
+// erroneous result is encoded as an object whose "error"
+// key holds the error string, such as {"error": "message"}.
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.Ok() {
+		return json.Marshal(r.opt.value)
+	}
+
+	var message string
+	if r.err != nil {
+		message = r.err.Error()
+	}
+
+	return json.Marshal(struct {
+		Error string `json:"error"`
+	}{message})
+}
+
+// UnmarshalJSON will decode an object whose only key is
+// "error" into an erroneous result. Anything else is
+// decoded into the value of an OK result.
+//
+// Decoding is lossy: the original type of an error cannot
+// be recovered, so the decoded error is created with
+// errors.New from the message. Similarly, an OK value that
+// is encoded as an object with only an "error" key will be
+// decoded as an erroneous result.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(data, &fields) == nil && len(fields) == 1 {
+		var message string
+		if raw, ok := fields["error"]; ok && json.Unmarshal(raw, &message) == nil {
+			*r = Err[T](errors.New(message))
+			return nil
+		}
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*r = Ok(value)
+	return nil
+}
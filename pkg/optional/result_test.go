@@ -1,6 +1,7 @@
 package optional_test
 
 import (
+	"encoding/json"
 	"errors"
 	"strconv"
 	"testing"
@@ -38,3 +39,57 @@ func TestResultStringWithError(t *testing.T) {
 	r := optional.Err[int](Error)
 	assert.Equal(t, Error.Error(), r.String())
 }
+
+func TestResultOr(t *testing.T) {
+	var Error error = errors.New("error")
+	assert.Equal(t, optional.Ok(1), optional.Ok(1).Or(optional.Ok(2)))
+	assert.Equal(t, optional.Ok(2), optional.Err[int](Error).Or(optional.Ok(2)))
+}
+
+func TestResultGetOrElse(t *testing.T) {
+	var Error error = errors.New("message")
+	fallback := func(err error) int { return len(err.Error()) }
+
+	assert.Equal(t, 42, optional.Ok(42).GetOrElse(fallback))
+	assert.Equal(t, len(Error.Error()), optional.Err[int](Error).GetOrElse(fallback))
+}
+
+func TestResultMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(optional.Ok(42))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `42`, string(data))
+
+	data, err = json.Marshal(optional.Err[int](errors.New("failed")))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"error": "failed"}`, string(data))
+}
+
+func TestResultUnmarshalJSON(t *testing.T) {
+	var r optional.Result[[]int]
+
+	assert.NoError(t, json.Unmarshal([]byte(`[1, 2]`), &r))
+	assert.True(t, r.Ok())
+	assert.Equal(t, []int{1, 2}, r.Expect())
+}
+
+func TestResultUnmarshalJSONErr(t *testing.T) {
+	var r optional.Result[int]
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"error": "failed"}`), &r))
+	assert.False(t, r.Ok())
+	assert.EqualError(t, r.Err(), "failed")
+}
+
+func TestResultUnmarshalJSONObject(t *testing.T) {
+	var r optional.Result[map[string]string]
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"error": "none", "status": "ok"}`), &r))
+	assert.True(t, r.Ok())
+	assert.Equal(t, map[string]string{"error": "none", "status": "ok"}, r.Expect())
+}
+
+func TestResultUnmarshalJSONInvalid(t *testing.T) {
+	var r optional.Result[int]
+
+	assert.Error(t, json.Unmarshal([]byte(`"42"`), &r))
+}
@@ -0,0 +1,14 @@
+package optional
+
+// Pair represents two values grouped together.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple represents three values grouped together.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
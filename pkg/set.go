@@ -0,0 +1,97 @@
+package functional
+
+import (
+	"github.com/standoffvenus/functional/v2/pkg/iterator"
+	"github.com/standoffvenus/functional/v2/pkg/optional"
+)
+
+// Difference will return an iterator on the distinct values
+// of a that are not values of b, in the order they first
+// occur in a. b is drained when the first value is retrieved.
+func Difference[T comparable](a, b iterator.Iterator[T]) iterator.Iterator[T] {
+	return filterBySet(a, b, false)
+}
+
+// Intersection will return an iterator on the distinct values
+// of a that are also values of b, in the order they first
+// occur in a. b is drained when the first value is retrieved.
+func Intersection[T comparable](a, b iterator.Iterator[T]) iterator.Iterator[T] {
+	return filterBySet(a, b, true)
+}
+
+// SetIterator will return an iterator on the elements of the
+// provided set. The order of the elements is unspecified.
+func SetIterator[T comparable](set map[T]struct{}) iterator.Enumerable[T] {
+	values := make([]T, 0, len(set))
+	for v := range set {
+		values = append(values, v)
+	}
+
+	return &iterator.Slice[T]{Values: values}
+}
+
+// ToSet will call Next() until None is encountered, storing
+// the results as the keys of a map.
+func ToSet[T comparable](iter iterator.Iterator[T]) map[T]struct{} {
+	set := make(map[T]struct{}, getSizeHint(iter))
+	ForEach(iter, func(t T, _ Break) {
+		set[t] = struct{}{}
+	})
+
+	return set
+}
+
+// Union will return an iterator on the distinct values of a,
+// in the order they first occur, followed by the distinct
+// values of b that are not values of a, in the order they
+// first occur. Values are retrieved lazily.
+func Union[T comparable](a, b iterator.Iterator[T]) iterator.Iterator[T] {
+	seen := make(map[T]struct{})
+	unseen := func(t T) bool {
+		if _, ok := seen[t]; ok {
+			return false
+		}
+
+		seen[t] = struct{}{}
+		return true
+	}
+
+	return iterator.Func[T](func() optional.Option[T] {
+		for _, iter := range []iterator.Iterator[T]{a, b} {
+			for opt := next(iter); opt.IsSome(); opt = next(iter) {
+				if unseen(opt.Expect()) {
+					return opt
+				}
+			}
+		}
+
+		return optional.None[T]()
+	})
+}
+
+// filterBySet will return an iterator on the distinct values
+// of a whose membership in b equals member.
+func filterBySet[T comparable](a, b iterator.Iterator[T], member bool) iterator.Iterator[T] {
+	var set map[T]struct{}
+	yielded := make(map[T]struct{})
+
+	return iterator.Func[T](func() optional.Option[T] {
+		if set == nil {
+			set = ToSet(b)
+		}
+
+		for opt := next(a); opt.IsSome(); opt = next(a) {
+			v := opt.Expect()
+			if _, ok := yielded[v]; ok {
+				continue
+			}
+
+			if _, ok := set[v]; ok == member {
+				yielded[v] = struct{}{}
+				return opt
+			}
+		}
+
+		return optional.None[T]()
+	})
+}
@@ -0,0 +1,46 @@
+package functional_test
+
+import (
+	"testing"
+
+	functional "github.com/standoffvenus/functional/v2/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetIterator(t *testing.T) {
+	set := map[int]struct{}{1: {}, 2: {}, 3: {}}
+	iter := functional.SetIterator(set)
+
+	assert.Equal(t, 3, iter.Count())
+	assert.ElementsMatch(t, []int{1, 2, 3}, functional.Collect[int](iter))
+}
+
+func TestToSet(t *testing.T) {
+	set := functional.ToSet(Iterator(1, 2, 1, 3))
+
+	assert.Equal(t, map[int]struct{}{1: {}, 2: {}, 3: {}}, set)
+}
+
+func TestUnion(t *testing.T) {
+	union := functional.Union(Iterator(3, 1, 3, 2), Iterator(4, 1, 5, 4))
+
+	assert.Equal(t, []int{3, 1, 2, 4, 5}, functional.Collect(union))
+}
+
+func TestIntersection(t *testing.T) {
+	intersection := functional.Intersection(Iterator(3, 1, 3, 2, 4), Iterator(4, 3, 5))
+
+	assert.Equal(t, []int{3, 4}, functional.Collect(intersection))
+}
+
+func TestDifference(t *testing.T) {
+	difference := functional.Difference(Iterator(3, 1, 3, 2, 1, 4), Iterator(4, 3, 5))
+
+	assert.Equal(t, []int{1, 2}, functional.Collect(difference))
+}
+
+func TestSetOperationsWithEmptyOperands(t *testing.T) {
+	assert.Empty(t, functional.Collect(functional.Union(Iterator[int](), Iterator[int]())))
+	assert.Empty(t, functional.Collect(functional.Intersection(Iterator(1), Iterator[int]())))
+	assert.Equal(t, []int{1}, functional.Collect(functional.Difference(Iterator(1), Iterator[int]())))
+}
@@ -0,0 +1,111 @@
+package functional
+
+import "github.com/standoffvenus/functional/v2/pkg/iterator"
+
+// Transducer transforms a step function accepting values of
+// Out into a step function accepting values of In. A step
+// function returns false once no more values should be
+// passed to it.
+//
+// Transducers are applied by Transduce, which passes each
+// value of an iterator through the transformed step function
+// in a single pass, without creating intermediate iterators
+// or slices. Any state a transducer needs (such as the count
+// kept by Taking) is created when the transducer is applied,
+// so a transducer may be reused.
+//
+// A transducer that will not accept any values returns a nil
+// step function, so that Transduce does not retrieve any
+// values; given a nil step function, a transducer should
+// likewise return nil.
+type Transducer[In, Out any] func(step func(Out) bool) func(In) bool
+
+// ComposeTransducers will return a transducer that transforms
+// values with first, then with second, i.e.
+//  ComposeTransducers(Filtering(f), Mapping(g))
+// passes the values "x" such that f(x) holds true through g.
+func ComposeTransducers[A, B, C any](first Transducer[A, B], second Transducer[B, C]) Transducer[A, C] {
+	return func(step func(C) bool) func(A) bool {
+		return first(second(step))
+	}
+}
+
+// Filtering will return a transducer that only passes
+// values "x" such that fn(x) holds true.
+func Filtering[T any](fn func(T) bool) Transducer[T, T] {
+	return func(step func(T) bool) func(T) bool {
+		if step == nil {
+			return nil
+		}
+
+		return func(t T) bool {
+			if fn(t) {
+				return step(t)
+			}
+
+			return true
+		}
+	}
+}
+
+// Mapping will return a transducer that passes the result
+// of calling fn with each value.
+func Mapping[From, To any](fn func(From) To) Transducer[From, To] {
+	return func(step func(To) bool) func(From) bool {
+		if step == nil {
+			return nil
+		}
+
+		return func(x From) bool {
+			return step(fn(x))
+		}
+	}
+}
+
+// Taking will return a transducer that passes the first n
+// values, then stops. If n is not positive, no values are
+// passed.
+func Taking[T any](n int) Transducer[T, T] {
+	return func(step func(T) bool) func(T) bool {
+		if step == nil || n <= 0 {
+			return nil
+		}
+
+		taken := 0
+
+		return func(t T) bool {
+			taken++
+			return step(t) && taken < n
+		}
+	}
+}
+
+// Transduce will reduce the values of the provided iterator
+// after they have been transformed by xf, starting with
+// initial as the accumulated value. Iteration stops once the
+// iterator is exhausted or xf stops. If xf will not accept any
+// values, no values are retrieved.
+func Transduce[In, Out, Acc any](
+	iter iterator.Iterator[In],
+	xf Transducer[In, Out],
+	initial Acc,
+	fn func(Acc, Out) Acc,
+) Acc {
+	accumulator := initial
+	step := xf(func(x Out) bool {
+		accumulator = fn(accumulator, x)
+		return true
+	})
+
+	if step == nil {
+		return accumulator
+	}
+
+	ForEach(iter, func(x In, stop Break) {
+		if !step(x) {
+			stop()
+		}
+	})
+
+	return accumulator
+}
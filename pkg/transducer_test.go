@@ -0,0 +1,57 @@
+package functional_test
+
+import (
+	"strconv"
+	"testing"
+
+	functional "github.com/standoffvenus/functional/v2/pkg"
+	"github.com/standoffvenus/functional/v2/pkg/iterator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransduce(t *testing.T) {
+	xf := functional.ComposeTransducers(
+		functional.Filtering(GreaterThan0),
+		functional.ComposeTransducers(
+			functional.Mapping(strconv.Itoa),
+			functional.Taking[string](2)))
+
+	iter := Iterator(-1, 1, 0, 2, 3, 4)
+	collected := functional.Transduce(iter, xf, []string(nil), appending[string])
+
+	assert.Equal(t, []string{"1", "2"}, collected)
+	AssertIteratorEqual(t, []int{3, 4}, iter)
+}
+
+func TestTransduceSum(t *testing.T) {
+	double := functional.Mapping(func(x int) int { return x * 2 })
+	sum := functional.Transduce(Iterator(1, 2, 3), double, 0, func(acc, x int) int { return acc + x })
+
+	assert.Equal(t, 12, sum)
+}
+
+func TestTransducerIsReusable(t *testing.T) {
+	xf := functional.Taking[int](1)
+
+	assert.Equal(t, []int{1}, functional.Transduce(Iterator(1, 2), xf, nil, appending[int]))
+	assert.Equal(t, []int{3}, functional.Transduce(Iterator(3, 4), xf, nil, appending[int]))
+}
+
+func TestTakingNonPositive(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{1, 2}}
+	collected := functional.Transduce[int](iter, functional.Taking[int](0), nil, appending[int])
+
+	assert.Empty(t, collected)
+	assert.Equal(t, 2, iter.Count())
+}
+
+func TestTakingNonPositiveComposed(t *testing.T) {
+	iter := &iterator.Slice[int]{Values: []int{1, 2}}
+	xf := functional.ComposeTransducers(functional.Mapping(strconv.Itoa), functional.Taking[string](-1))
+	collected := functional.Transduce[int](iter, xf, nil, appending[string])
+
+	assert.Empty(t, collected)
+	assert.Equal(t, 2, iter.Count())
+}
+
+func appending[T any](acc []T, x T) []T { return append(acc, x) }